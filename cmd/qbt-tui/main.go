@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,6 +21,7 @@ var (
 	username   string
 	password   string
 	apiKey     string
+	proxy      string
 	refreshInt int
 	debugMode  bool
 	logFile    string
@@ -54,6 +56,7 @@ CONFIGURATION:
     QBT_SERVER_USERNAME      qBittorrent username
     QBT_SERVER_PASSWORD      qBittorrent password
     QBT_SERVER_API_KEY       qBittorrent API key (≥5.2.0, alternative to user/pass)
+    QBT_SERVER_PROXY         Proxy URL (http://, https:// or socks5://)
     QBT_UI_REFRESH_INTERVAL  Refresh interval in seconds (default: 3)
 
 EXAMPLES:
@@ -82,7 +85,13 @@ KEYBOARD SHORTCUTS:
     g            Go to top
     G            Go to bottom
     Enter        View torrent details
-    Esc          Return to main view
+    Esc          Return to main view / clear marks
+  
+  Selection:
+    Space        Mark/unmark torrent
+    V            Visual range selection
+    Ctrl+A       Mark all visible torrents
+    I            Invert marks
   
   Filtering:
     f, /         Search torrents
@@ -107,10 +116,11 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "config file (default is $HOME/.config/qbt-tui/config.toml)")
 
 	// Server configuration flags
-	rootCmd.Flags().StringVarP(&serverURL, "url", "u", "", "qBittorrent WebUI URL")
+	rootCmd.Flags().StringVarP(&serverURL, "url", "u", "", "qBittorrent WebUI URL, or unix:///path/to/socket")
 	rootCmd.Flags().StringVar(&username, "username", "", "qBittorrent username")
 	rootCmd.Flags().StringVarP(&password, "password", "p", "", "qBittorrent password")
 	rootCmd.Flags().StringVar(&apiKey, "api-key", "", "qBittorrent API key (≥5.2.0, alternative to username/password)")
+	rootCmd.Flags().StringVar(&proxy, "proxy", "", "proxy URL (http://, https:// or socks5://)")
 
 	// UI configuration flags
 	rootCmd.Flags().IntVarP(&refreshInt, "refresh", "r", 3, "refresh interval in seconds (default: 3)")
@@ -144,17 +154,11 @@ func run(cmd *cobra.Command, args []string) error {
 	// Create and connect API client. API-key auth (qBittorrent ≥5.2.0) is
 	// stateless and skips the /auth/login round-trip; the docs forbid using
 	// API keys against /auth/login. Otherwise fall back to user/pass login.
-	var client *api.Client
-	if cfg.Server.APIKey != "" {
-		client, err = api.NewClientWithAPIKey(cfg.Server.URL, cfg.Server.APIKey)
-		if err != nil {
-			return fmt.Errorf("failed to create API client: %w", err)
-		}
-	} else {
-		client, err = api.NewClient(cfg.Server.URL)
-		if err != nil {
-			return fmt.Errorf("failed to create API client: %w", err)
-		}
+	client, err := api.NewClientWithOptions(cfg.Server.URL, clientOptions(cfg.Server))
+	if err != nil {
+		return fmt.Errorf("failed to create API client: %w", err)
+	}
+	if cfg.Server.APIKey == "" {
 		if err := client.Login(cfg.Server.Username, cfg.Server.Password); err != nil {
 			return fmt.Errorf("failed to connect to qBittorrent API: %w", err)
 		}
@@ -173,3 +177,22 @@ func run(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// clientOptions converts the server configuration into API client options
+func clientOptions(server config.ServerConfig) api.ClientOptions {
+	seconds := func(n int) time.Duration { return time.Duration(n) * time.Second }
+	return api.ClientOptions{
+		APIKey: server.APIKey,
+		Proxy:  server.Proxy,
+		TLS: api.TLSOptions{
+			CAFile:             server.TLS.CAFile,
+			CertFile:           server.TLS.CertFile,
+			KeyFile:            server.TLS.KeyFile,
+			InsecureSkipVerify: server.TLS.InsecureSkipVerify,
+			ServerName:         server.TLS.ServerName,
+		},
+		Timeout:               seconds(server.Timeout),
+		ConnectTimeout:        seconds(server.ConnectTimeout),
+		ResponseHeaderTimeout: seconds(server.ResponseHeaderTimeout),
+	}
+}
@@ -13,13 +13,22 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
-	"time"
+	"sync"
 )
 
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
+
+	// Credentials from the last successful Login, used to log in again when
+	// the session expires (server restart, session timeout)
+	authMu      sync.Mutex
+	username    string
+	password    string
+	credentials bool
+	session     int // Incremented on every successful login
 }
 
 // isSuccessStatus reports whether the HTTP status code is in the 2xx range.
@@ -56,18 +65,7 @@ func (t *bearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, erro
 }
 
 func NewClient(baseURL string) (*Client, error) {
-	jar, err := cookiejar.New(nil)
-	if err != nil {
-		return nil, NewValidationError("failed to create cookie jar", err)
-	}
-
-	return &Client{
-		baseURL: strings.TrimRight(baseURL, "/"),
-		httpClient: &http.Client{
-			Jar:     jar,
-			Timeout: 10 * time.Second,
-		},
-	}, nil
+	return NewClientWithOptions(baseURL, ClientOptions{})
 }
 
 // NewClientWithAPIKey returns a Client that authenticates via qBittorrent's
@@ -80,21 +78,69 @@ func NewClient(baseURL string) (*Client, error) {
 // Callers must not invoke Login on a client built this way — qBittorrent
 // rejects API keys at /api/v2/auth/login and /api/v2/auth/logout.
 func NewClientWithAPIKey(baseURL, apiKey string) (*Client, error) {
-	u, err := url.Parse(baseURL)
-	if err != nil || u.Host == "" {
-		return nil, NewValidationError("invalid base URL", err)
+	return NewClientWithOptions(baseURL, ClientOptions{APIKey: apiKey})
+}
+
+// NewClientWithOptions returns a Client configured by opts, authenticating
+// with opts.APIKey like NewClientWithAPIKey when set, or through Login
+// otherwise.
+//
+// baseURL may also be unix:///path/to/socket to talk HTTP over a Unix socket.
+func NewClientWithOptions(baseURL string, opts ClientOptions) (*Client, error) {
+	baseURL, socketPath, err := resolveServerURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := newTransport(opts, socketPath)
+	if err != nil {
+		return nil, err
 	}
 
-	return &Client{
-		baseURL: strings.TrimRight(baseURL, "/"),
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	client := &Client{
+		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout:   10 * time.Second,
-			Transport: &bearerAuthTransport{key: apiKey, host: u.Host, base: http.DefaultTransport},
+			Timeout:   timeout,
+			Transport: transport,
 		},
-	}, nil
+	}
+
+	if opts.APIKey != "" {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Host == "" {
+			return nil, NewValidationError("invalid base URL", err)
+		}
+		client.httpClient.Transport = &bearerAuthTransport{key: opts.APIKey, host: u.Host, base: transport}
+		return client, nil
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, NewValidationError("failed to create cookie jar", err)
+	}
+	client.httpClient.Jar = jar
+	return client, nil
 }
 
+// Login authenticates with the server. The credentials are remembered so that
+// requests rejected by an expired session log in again and retry once.
 func (c *Client) Login(username, password string) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if err := c.login(username, password); err != nil {
+		return err
+	}
+	c.username, c.password, c.credentials = username, password, true
+	return nil
+}
+
+// login performs the login request; callers hold authMu
+func (c *Client) login(username, password string) error {
 	data := url.Values{
 		"username": {username},
 		"password": {password},
@@ -139,6 +185,7 @@ func (c *Client) Login(username, password string) error {
 	// 5.2+ uses "QBT_SID_<port>" (e.g. QBT_SID_8112).
 	for _, cookie := range c.httpClient.Jar.Cookies(resp.Request.URL) {
 		if cookie.Name == "SID" || strings.HasPrefix(cookie.Name, "QBT_SID") {
+			c.session++
 			return nil
 		}
 	}
@@ -146,6 +193,54 @@ func (c *Client) Login(username, password string) error {
 	return NewServerError(0, "no session cookie received", nil)
 }
 
+// do sends a request. When the server answers 403 Forbidden and Login has
+// stored credentials, the session has most likely expired: log in again and
+// retry the request once. If logging in again fails, the original 403
+// response is returned so callers report it as an auth error.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	c.authMu.Lock()
+	session, canRelogin := c.session, c.credentials
+	c.authMu.Unlock()
+
+	// Copy the request before sending it, as the client adds the session
+	// cookie to the original
+	var retry *http.Request
+	if canRelogin && (req.Body == nil || req.GetBody != nil) {
+		retry = req.Clone(req.Context())
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden || retry == nil {
+		return resp, err
+	}
+
+	if err := c.relogin(session); err != nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return c.httpClient.Do(retry)
+}
+
+// relogin logs in again with the stored credentials, unless another request
+// already did so since session was read
+func (c *Client) relogin(session int) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if c.session != session {
+		return nil
+	}
+	return c.login(c.username, c.password)
+}
+
 func (c *Client) GetTorrents(ctx context.Context) ([]Torrent, error) {
 	return c.GetTorrentsFiltered(ctx, nil)
 }
@@ -207,8 +302,8 @@ func (c *Client) GetTorrentProperties(ctx context.Context, hash string) (*Torren
 	return &props, nil
 }
 
-func (c *Client) GetCategories(ctx context.Context) (map[string]interface{}, error) {
-	var categories map[string]interface{}
+func (c *Client) GetCategories(ctx context.Context) (map[string]Category, error) {
+	var categories map[string]Category
 	if err := c.get(ctx, "/api/v2/torrents/categories", &categories); err != nil {
 		return nil, err
 	}
@@ -216,6 +311,488 @@ func (c *Client) GetCategories(ctx context.Context) (map[string]interface{}, err
 	return categories, nil
 }
 
+// RecheckTorrents verifies the downloaded data of one or more torrents
+func (c *Client) RecheckTorrents(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/recheck", data, "recheck")
+}
+
+// ReannounceTorrents announces one or more torrents to all their trackers
+func (c *Client) ReannounceTorrents(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/reannounce", data, "reannounce")
+}
+
+// SetForceStart enables or disables force start, which bypasses the queue limits
+func (c *Client) SetForceStart(ctx context.Context, hashes []string, enabled bool) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+		"value":  {strconv.FormatBool(enabled)},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/setForceStart", data, "set force start")
+}
+
+// ToggleSequentialDownload flips sequential download on each of the torrents
+func (c *Client) ToggleSequentialDownload(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/toggleSequentialDownload", data, "toggle sequential download")
+}
+
+// ToggleFirstLastPiecePrio flips first/last piece priority on each of the torrents
+func (c *Client) ToggleFirstLastPiecePrio(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/toggleFirstLastPiecePrio", data, "toggle first/last piece priority")
+}
+
+// AddTrackers adds tracker announce URLs to a torrent
+func (c *Client) AddTrackers(ctx context.Context, hash string, urls []string) error {
+	data := url.Values{
+		"hash": {hash},
+		"urls": {strings.Join(urls, "\n")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/addTrackers", data, "add trackers")
+}
+
+// EditTracker replaces a tracker announce URL of a torrent
+func (c *Client) EditTracker(ctx context.Context, hash, origURL, newURL string) error {
+	data := url.Values{
+		"hash":    {hash},
+		"origUrl": {origURL},
+		"newUrl":  {newURL},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/editTracker", data, "edit tracker")
+}
+
+// RemoveTrackers removes tracker announce URLs from a torrent
+func (c *Client) RemoveTrackers(ctx context.Context, hash string, urls []string) error {
+	data := url.Values{
+		"hash": {hash},
+		"urls": {strings.Join(urls, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/removeTrackers", data, "remove trackers")
+}
+
+// AddPeers asks torrents to connect to peers given as "ip:port" addresses
+func (c *Client) AddPeers(ctx context.Context, hashes []string, peers []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+		"peers":  {strings.Join(peers, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/addPeers", data, "add peers")
+}
+
+// BanPeers permanently bans peers given as "ip:port" addresses; the ban
+// applies to the IP across all torrents
+func (c *Client) BanPeers(ctx context.Context, peers []string) error {
+	data := url.Values{
+		"peers": {strings.Join(peers, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/transfer/banPeers", data, "ban peers")
+}
+
+// IncreasePriority moves torrents one position up in the queue
+func (c *Client) IncreasePriority(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/increasePrio", data, "increase priority")
+}
+
+// DecreasePriority moves torrents one position down in the queue
+func (c *Client) DecreasePriority(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/decreasePrio", data, "decrease priority")
+}
+
+// TopPriority moves torrents to the top of the queue
+func (c *Client) TopPriority(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/topPrio", data, "top priority")
+}
+
+// BottomPriority moves torrents to the bottom of the queue
+func (c *Client) BottomPriority(ctx context.Context, hashes []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/bottomPrio", data, "bottom priority")
+}
+
+// SetTorrentDownloadLimit sets the download limit of torrents in bytes/s (0 for unlimited)
+func (c *Client) SetTorrentDownloadLimit(ctx context.Context, hashes []string, limit int64) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+		"limit":  {strconv.FormatInt(limit, 10)},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/setDownloadLimit", data, "set download limit")
+}
+
+// SetTorrentUploadLimit sets the upload limit of torrents in bytes/s (0 for unlimited)
+func (c *Client) SetTorrentUploadLimit(ctx context.Context, hashes []string, limit int64) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+		"limit":  {strconv.FormatInt(limit, 10)},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/setUploadLimit", data, "set upload limit")
+}
+
+// SetGlobalDownloadLimit sets the global download limit in bytes/s (0 for unlimited)
+func (c *Client) SetGlobalDownloadLimit(ctx context.Context, limit int64) error {
+	data := url.Values{
+		"limit": {strconv.FormatInt(limit, 10)},
+	}
+	return c.postForm(ctx, "/api/v2/transfer/setDownloadLimit", data, "set global download limit")
+}
+
+// SetGlobalUploadLimit sets the global upload limit in bytes/s (0 for unlimited)
+func (c *Client) SetGlobalUploadLimit(ctx context.Context, limit int64) error {
+	data := url.Values{
+		"limit": {strconv.FormatInt(limit, 10)},
+	}
+	return c.postForm(ctx, "/api/v2/transfer/setUploadLimit", data, "set global upload limit")
+}
+
+// ToggleAltSpeedLimits switches alternative speed limits on or off
+func (c *Client) ToggleAltSpeedLimits(ctx context.Context) error {
+	return c.postForm(ctx, "/api/v2/transfer/toggleSpeedLimitsMode", url.Values{}, "toggle alternative speed limits")
+}
+
+// GetAltSpeedLimitsMode returns whether alternative speed limits are active
+func (c *Client) GetAltSpeedLimitsMode(ctx context.Context) (bool, error) {
+	var mode int
+	if err := c.get(ctx, "/api/v2/transfer/speedLimitsMode", &mode); err != nil {
+		return false, err
+	}
+	return mode == 1, nil
+}
+
+// GetPreferences retrieves the application preferences
+func (c *Client) GetPreferences(ctx context.Context) (*Preferences, error) {
+	var prefs Preferences
+	if err := c.get(ctx, "/api/v2/app/preferences", &prefs); err != nil {
+		return nil, err
+	}
+	return &prefs, nil
+}
+
+// SetPreferences updates application preferences; changes maps API keys to
+// their new values (see Preferences.Changes) and keys left out keep their value
+func (c *Client) SetPreferences(ctx context.Context, changes map[string]any) error {
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return NewValidationError("failed to encode preferences", err)
+	}
+	data := url.Values{
+		"json": {string(encoded)},
+	}
+	return c.postForm(ctx, "/api/v2/app/setPreferences", data, "set preferences")
+}
+
+// GetRSSItems retrieves all RSS feeds, flattened out of their folders and
+// sorted by path; withData includes each feed's articles
+func (c *Client) GetRSSItems(ctx context.Context, withData bool) ([]RSSFeed, error) {
+	endpoint := fmt.Sprintf("/api/v2/rss/items?withData=%t", withData)
+	var items map[string]json.RawMessage
+	if err := c.get(ctx, endpoint, &items); err != nil {
+		return nil, err
+	}
+
+	feeds, err := parseRSSItems(items, "")
+	if err != nil {
+		return nil, NewServerError(0, "failed to decode RSS items", err)
+	}
+	return feeds, nil
+}
+
+// AddRSSFeed subscribes to a feed; path is where it goes in the feed tree and
+// defaults to the feed URL when empty
+func (c *Client) AddRSSFeed(ctx context.Context, feedURL, path string) error {
+	data := url.Values{
+		"url": {feedURL},
+	}
+	if path != "" {
+		data.Set("path", path)
+	}
+	return c.postForm(ctx, "/api/v2/rss/addFeed", data, "add RSS feed")
+}
+
+// RemoveRSSItem removes a feed or folder
+func (c *Client) RemoveRSSItem(ctx context.Context, path string) error {
+	data := url.Values{
+		"path": {path},
+	}
+	return c.postForm(ctx, "/api/v2/rss/removeItem", data, "remove RSS item")
+}
+
+// RefreshRSSItem asks the server to reload a feed, or every feed in a folder;
+// an empty path refreshes all feeds
+func (c *Client) RefreshRSSItem(ctx context.Context, path string) error {
+	data := url.Values{
+		"itemPath": {path},
+	}
+	return c.postForm(ctx, "/api/v2/rss/refreshItem", data, "refresh RSS item")
+}
+
+// MarkRSSAsRead marks one article of a feed as read, or every article in the
+// feed or folder when articleID is empty
+func (c *Client) MarkRSSAsRead(ctx context.Context, path, articleID string) error {
+	data := url.Values{
+		"itemPath": {path},
+	}
+	if articleID != "" {
+		data.Set("articleId", articleID)
+	}
+	return c.postForm(ctx, "/api/v2/rss/markAsRead", data, "mark RSS articles as read")
+}
+
+// GetRSSRules retrieves the RSS auto-download rules by name
+func (c *Client) GetRSSRules(ctx context.Context) (map[string]RSSRule, error) {
+	var rules map[string]RSSRule
+	if err := c.get(ctx, "/api/v2/rss/rules", &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// SetRSSRule creates an auto-download rule, or replaces the rule with that name
+func (c *Client) SetRSSRule(ctx context.Context, name string, rule RSSRule) error {
+	encoded, err := json.Marshal(rule)
+	if err != nil {
+		return NewValidationError("failed to encode RSS rule", err)
+	}
+	data := url.Values{
+		"ruleName": {name},
+		"ruleDef":  {string(encoded)},
+	}
+	return c.postForm(ctx, "/api/v2/rss/setRule", data, "set RSS rule")
+}
+
+// GetRSSMatchingArticles retrieves the titles of the articles a saved rule
+// matches, keyed by feed name
+func (c *Client) GetRSSMatchingArticles(ctx context.Context, ruleName string) (map[string][]string, error) {
+	endpoint := "/api/v2/rss/matchingArticles?" + url.Values{"ruleName": {ruleName}}.Encode()
+	var matches map[string][]string
+	if err := c.get(ctx, endpoint, &matches); err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// StartSearch starts a search job and returns its ID. plugins limits the
+// search to those plugins, searching all enabled plugins when empty; an
+// empty category searches all categories.
+func (c *Client) StartSearch(ctx context.Context, pattern string, plugins []string, category string) (int, error) {
+	data := url.Values{
+		"pattern":  {pattern},
+		"plugins":  {"enabled"},
+		"category": {"all"},
+	}
+	if len(plugins) > 0 {
+		data.Set("plugins", strings.Join(plugins, "|"))
+	}
+	if category != "" {
+		data.Set("category", category)
+	}
+
+	var job struct {
+		ID int `json:"id"`
+	}
+	if err := c.postFormJSON(ctx, "/api/v2/search/start", data, "start search", &job); err != nil {
+		return 0, err
+	}
+	return job.ID, nil
+}
+
+// GetSearchStatus retrieves the state of a search job, or of every job when id is 0
+func (c *Client) GetSearchStatus(ctx context.Context, id int) ([]SearchStatus, error) {
+	endpoint := "/api/v2/search/status"
+	if id != 0 {
+		endpoint += fmt.Sprintf("?id=%d", id)
+	}
+	var statuses []SearchStatus
+	if err := c.get(ctx, endpoint, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// GetSearchResults retrieves a search job's results starting at offset; a
+// limit of 0 returns all of them
+func (c *Client) GetSearchResults(ctx context.Context, id, limit, offset int) (*SearchResults, error) {
+	endpoint := fmt.Sprintf("/api/v2/search/results?id=%d&limit=%d&offset=%d", id, limit, offset)
+	var results SearchResults
+	if err := c.get(ctx, endpoint, &results); err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// StopSearch stops a running search job, keeping its results
+func (c *Client) StopSearch(ctx context.Context, id int) error {
+	data := url.Values{
+		"id": {strconv.Itoa(id)},
+	}
+	return c.postForm(ctx, "/api/v2/search/stop", data, "stop search")
+}
+
+// DeleteSearch stops a search job and discards its results
+func (c *Client) DeleteSearch(ctx context.Context, id int) error {
+	data := url.Values{
+		"id": {strconv.Itoa(id)},
+	}
+	return c.postForm(ctx, "/api/v2/search/delete", data, "delete search")
+}
+
+// GetSearchPlugins retrieves the installed search plugins
+func (c *Client) GetSearchPlugins(ctx context.Context) ([]SearchPlugin, error) {
+	var plugins []SearchPlugin
+	if err := c.get(ctx, "/api/v2/search/plugins", &plugins); err != nil {
+		return nil, err
+	}
+	return plugins, nil
+}
+
+// EnableSearchPlugins enables or disables search plugins by name
+func (c *Client) EnableSearchPlugins(ctx context.Context, names []string, enable bool) error {
+	data := url.Values{
+		"names":  {strings.Join(names, "|")},
+		"enable": {strconv.FormatBool(enable)},
+	}
+	return c.postForm(ctx, "/api/v2/search/enablePlugin", data, "enable search plugins")
+}
+
+// GetLog retrieves the server's main log messages of the given severities
+// logged after lastKnownID. Pass -1 to get every message the server keeps.
+func (c *Client) GetLog(ctx context.Context, types LogType, lastKnownID int) ([]LogMessage, error) {
+	params := url.Values{"last_known_id": {strconv.Itoa(lastKnownID)}}
+	for _, t := range LogTypes {
+		params.Set(t.String(), strconv.FormatBool(types&t != 0))
+	}
+
+	var messages []LogMessage
+	if err := c.get(ctx, "/api/v2/log/main?"+params.Encode(), &messages); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// GetPeerLog retrieves the server's peer log entries logged after
+// lastKnownID. Pass -1 to get every entry the server keeps.
+func (c *Client) GetPeerLog(ctx context.Context, lastKnownID int) ([]PeerLogEntry, error) {
+	endpoint := fmt.Sprintf("/api/v2/log/peers?last_known_id=%d", lastKnownID)
+	var entries []PeerLogEntry
+	if err := c.get(ctx, endpoint, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// SetShareLimits sets the ratio and seeding time limits of torrents. The
+// inactive seeding time limit is always sent: qBittorrent 4.6+ requires it and
+// older servers ignore the extra field.
+func (c *Client) SetShareLimits(ctx context.Context, hashes []string, limits ShareLimits) error {
+	data := url.Values{
+		"hashes":                   {strings.Join(hashes, "|")},
+		"ratioLimit":               {strconv.FormatFloat(limits.RatioLimit, 'f', -1, 64)},
+		"seedingTimeLimit":         {strconv.FormatInt(limits.SeedingTimeLimit, 10)},
+		"inactiveSeedingTimeLimit": {strconv.FormatInt(limits.InactiveSeedingTimeLimit, 10)},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/setShareLimits", data, "set share limits")
+}
+
+// SetFilePriority sets the download priority of files within a torrent.
+// fileIDs are the TorrentFile indexes; see the FilePriority constants.
+func (c *Client) SetFilePriority(ctx context.Context, hash string, fileIDs []int, priority int) error {
+	ids := make([]string, len(fileIDs))
+	for i, id := range fileIDs {
+		ids[i] = strconv.Itoa(id)
+	}
+	data := url.Values{
+		"hash":     {hash},
+		"id":       {strings.Join(ids, "|")},
+		"priority": {strconv.Itoa(priority)},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/filePrio", data, "set file priority")
+}
+
+// RenameTorrent changes the display name of a torrent
+func (c *Client) RenameTorrent(ctx context.Context, hash, name string) error {
+	data := url.Values{
+		"hash": {hash},
+		"name": {name},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/rename", data, "rename torrent")
+}
+
+// RenameFile renames or moves a file within a torrent. Paths are relative
+// to the torrent's content root, as reported by GetTorrentFiles.
+func (c *Client) RenameFile(ctx context.Context, hash, oldPath, newPath string) error {
+	data := url.Values{
+		"hash":    {hash},
+		"oldPath": {oldPath},
+		"newPath": {newPath},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/renameFile", data, "rename file")
+}
+
+// RenameFolder renames or moves a folder within a torrent
+func (c *Client) RenameFolder(ctx context.Context, hash, oldPath, newPath string) error {
+	data := url.Values{
+		"hash":    {hash},
+		"oldPath": {oldPath},
+		"newPath": {newPath},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/renameFolder", data, "rename folder")
+}
+
+// CreateCategory creates a new category with an optional save path
+func (c *Client) CreateCategory(ctx context.Context, name, savePath string) error {
+	data := url.Values{
+		"category": {name},
+		"savePath": {savePath},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/createCategory", data, "create category")
+}
+
+// EditCategory changes the save path of an existing category
+func (c *Client) EditCategory(ctx context.Context, name, savePath string) error {
+	data := url.Values{
+		"category": {name},
+		"savePath": {savePath},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/editCategory", data, "edit category")
+}
+
+// RemoveCategories removes one or more categories
+func (c *Client) RemoveCategories(ctx context.Context, names []string) error {
+	data := url.Values{
+		"categories": {strings.Join(names, "\n")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/removeCategories", data, "remove categories")
+}
+
+// SetCategory assigns a category to one or more torrents. An empty category
+// removes the torrents from their current category.
+func (c *Client) SetCategory(ctx context.Context, hashes []string, category string) error {
+	data := url.Values{
+		"hashes":   {strings.Join(hashes, "|")},
+		"category": {category},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/setCategory", data, "set category")
+}
+
 func (c *Client) GetTags(ctx context.Context) ([]string, error) {
 	var tags []string
 	if err := c.get(ctx, "/api/v2/torrents/tags", &tags); err != nil {
@@ -225,6 +802,40 @@ func (c *Client) GetTags(ctx context.Context) ([]string, error) {
 	return tags, nil
 }
 
+// AddTags adds tags to one or more torrents, creating any tags that do not exist yet
+func (c *Client) AddTags(ctx context.Context, hashes []string, tags []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+		"tags":   {strings.Join(tags, ",")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/addTags", data, "add tags")
+}
+
+// RemoveTags removes tags from one or more torrents
+func (c *Client) RemoveTags(ctx context.Context, hashes []string, tags []string) error {
+	data := url.Values{
+		"hashes": {strings.Join(hashes, "|")},
+		"tags":   {strings.Join(tags, ",")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/removeTags", data, "remove tags")
+}
+
+// CreateTags adds tags to the global tag list
+func (c *Client) CreateTags(ctx context.Context, tags []string) error {
+	data := url.Values{
+		"tags": {strings.Join(tags, ",")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/createTags", data, "create tags")
+}
+
+// DeleteTags removes tags from the global tag list and from all torrents
+func (c *Client) DeleteTags(ctx context.Context, tags []string) error {
+	data := url.Values{
+		"tags": {strings.Join(tags, ",")},
+	}
+	return c.postForm(ctx, "/api/v2/torrents/deleteTags", data, "delete tags")
+}
+
 // GetTorrentTrackers retrieves trackers for a specific torrent
 func (c *Client) GetTorrentTrackers(ctx context.Context, hash string) ([]Tracker, error) {
 	endpoint := fmt.Sprintf("/api/v2/torrents/trackers?hash=%s", hash)
@@ -251,6 +862,20 @@ func (c *Client) GetTorrentPeers(ctx context.Context, hash string) (map[string]P
 	return response.Peers, nil
 }
 
+// SyncTorrentPeers fetches incremental peer updates for a torrent.
+// Pass rid=0 for the first request to get all peers, then use the returned
+// rid for subsequent requests to get only changes since the last request
+func (c *Client) SyncTorrentPeers(ctx context.Context, hash string, rid int) (*SyncTorrentPeersResponse, error) {
+	endpoint := fmt.Sprintf("/api/v2/sync/torrentPeers?hash=%s&rid=%d", hash, rid)
+
+	var response SyncTorrentPeersResponse
+	if err := c.get(ctx, endpoint, &response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // GetTorrentFiles retrieves files for a specific torrent
 func (c *Client) GetTorrentFiles(ctx context.Context, hash string) ([]TorrentFile, error) {
 	endpoint := fmt.Sprintf("/api/v2/torrents/files?hash=%s", hash)
@@ -277,7 +902,7 @@ func (c *Client) PauseTorrents(ctx context.Context, hashes []string) error {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Referer", c.baseURL)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		if os.IsTimeout(err) {
 			return NewTimeoutError("pause request timed out", err)
@@ -309,7 +934,7 @@ func (c *Client) ResumeTorrents(ctx context.Context, hashes []string) error {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Referer", c.baseURL)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		if os.IsTimeout(err) {
 			return NewTimeoutError("resume request timed out", err)
@@ -346,7 +971,7 @@ func (c *Client) DeleteTorrents(ctx context.Context, hashes []string, deleteFile
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Referer", c.baseURL)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		if os.IsTimeout(err) {
 			return NewTimeoutError("delete request timed out", err)
@@ -363,7 +988,7 @@ func (c *Client) DeleteTorrents(ctx context.Context, hashes []string, deleteFile
 }
 
 // AddTorrentFile adds a torrent from a local .torrent file
-func (c *Client) AddTorrentFile(ctx context.Context, filePath string) error {
+func (c *Client) AddTorrentFile(ctx context.Context, filePath string, opts AddTorrentOptions) error {
 	// Read the torrent file
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -371,18 +996,42 @@ func (c *Client) AddTorrentFile(ctx context.Context, filePath string) error {
 	}
 	defer file.Close()
 
+	return c.addTorrent(ctx, opts, "add torrent", func(writer *multipart.Writer) error {
+		part, err := writer.CreateFormFile("torrents", filepath.Base(filePath))
+		if err != nil {
+			return NewValidationError("failed to create form file", err)
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			return NewValidationError("failed to copy file data", err)
+		}
+		return nil
+	})
+}
+
+// AddTorrentURL adds a torrent from a URL
+func (c *Client) AddTorrentURL(ctx context.Context, torrentURL string, opts AddTorrentOptions) error {
+	return c.addTorrent(ctx, opts, "add torrent URL", func(writer *multipart.Writer) error {
+		if err := writer.WriteField("urls", torrentURL); err != nil {
+			return NewValidationError("failed to write form field", err)
+		}
+		return nil
+	})
+}
+
+// addTorrent posts a torrents/add multipart form with the torrent written
+// by addSource followed by the options
+func (c *Client) addTorrent(ctx context.Context, opts AddTorrentOptions, action string, addSource func(*multipart.Writer) error) error {
 	// Create multipart form data
 	var body bytes.Buffer
 	writer := multipart.NewWriter(&body)
 
-	// Add the torrent file
-	part, err := writer.CreateFormFile("torrents", filepath.Base(filePath))
-	if err != nil {
-		return NewValidationError("failed to create form file", err)
+	if err := addSource(writer); err != nil {
+		return err
 	}
-
-	if _, err := io.Copy(part, file); err != nil {
-		return NewValidationError("failed to copy file data", err)
+	for _, field := range addTorrentFields(opts) {
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return NewValidationError("failed to write form field", err)
+		}
 	}
 
 	if err := writer.Close(); err != nil {
@@ -392,18 +1041,18 @@ func (c *Client) AddTorrentFile(ctx context.Context, filePath string) error {
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v2/torrents/add", &body)
 	if err != nil {
-		return NewValidationError("failed to create add request", err)
+		return NewValidationError(fmt.Sprintf("failed to create %s request", action), err)
 	}
 
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	req.Header.Set("Referer", c.baseURL)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		if os.IsTimeout(err) {
-			return NewTimeoutError("add torrent request timed out", err)
+			return NewTimeoutError(action+" request timed out", err)
 		}
-		return NewNetworkError("add torrent request failed", err)
+		return NewNetworkError(action+" request failed", err)
 	}
 	defer resp.Body.Close()
 
@@ -414,34 +1063,46 @@ func (c *Client) AddTorrentFile(ctx context.Context, filePath string) error {
 	return nil
 }
 
-// AddTorrentURL adds a torrent from a URL
-func (c *Client) AddTorrentURL(ctx context.Context, torrentURL string) error {
-	data := url.Values{
-		"urls": {torrentURL},
+// addTorrentFields converts add options into torrents/add form fields,
+// leaving out the ones that keep the server default. The paused state is
+// sent under both its pre-5.0 and current names.
+func addTorrentFields(opts AddTorrentOptions) [][2]string {
+	var fields [][2]string
+	add := func(name, value string) {
+		fields = append(fields, [2]string{name, value})
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v2/torrents/add", strings.NewReader(data.Encode()))
-	if err != nil {
-		return NewValidationError("failed to create add URL request", err)
+	if opts.SavePath != "" {
+		add("savepath", opts.SavePath)
 	}
-
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("Referer", c.baseURL)
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		if os.IsTimeout(err) {
-			return NewTimeoutError("add torrent URL request timed out", err)
-		}
-		return NewNetworkError("add torrent URL request failed", err)
+	if opts.Category != "" {
+		add("category", opts.Category)
 	}
-	defer resp.Body.Close()
-
-	if !isSuccessStatus(resp.StatusCode) {
-		return WrapHTTPError(resp, nil)
+	if len(opts.Tags) > 0 {
+		add("tags", strings.Join(opts.Tags, ","))
 	}
-
-	return nil
+	if opts.Paused {
+		add("paused", "true")
+		add("stopped", "true")
+	}
+	if opts.SkipChecking {
+		add("skip_checking", "true")
+	}
+	if opts.Sequential {
+		add("sequentialDownload", "true")
+	}
+	if opts.ContentLayout != "" {
+		add("contentLayout", opts.ContentLayout)
+	}
+	if opts.Rename != "" {
+		add("rename", opts.Rename)
+	}
+	if opts.Limits != nil {
+		add("ratioLimit", strconv.FormatFloat(opts.Limits.RatioLimit, 'f', -1, 64))
+		add("seedingTimeLimit", strconv.FormatInt(opts.Limits.SeedingTimeLimit, 10))
+		add("inactiveSeedingTimeLimit", strconv.FormatInt(opts.Limits.InactiveSeedingTimeLimit, 10))
+	}
+	return fields
 }
 
 func (c *Client) SetTorrentLocation(ctx context.Context, hashes []string, newLocation string) error {
@@ -458,7 +1119,7 @@ func (c *Client) SetTorrentLocation(ctx context.Context, hashes []string, newLoc
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Referer", c.baseURL)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		if os.IsTimeout(err) {
 			return NewTimeoutError("set location request timed out", err)
@@ -492,6 +1153,50 @@ func (c *Client) GetDirectoryContent(ctx context.Context, path string, mode stri
 	return result, nil
 }
 
+// postForm sends a form-encoded POST to an action endpoint that has no
+// response body. action is used to build error messages, e.g. "set category".
+func (c *Client) postForm(ctx context.Context, endpoint string, data url.Values, action string) error {
+	return c.postFormJSON(ctx, endpoint, data, action, nil)
+}
+
+// postFormJSON sends a form-encoded POST like postForm and decodes the JSON
+// response into v, unless v is nil
+func (c *Client) postFormJSON(ctx context.Context, endpoint string, data url.Values, action string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return NewValidationError(fmt.Sprintf("failed to create %s request", action), err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", c.baseURL)
+
+	resp, err := c.do(req)
+	if err != nil {
+		if os.IsTimeout(err) {
+			return NewTimeoutError(fmt.Sprintf("%s request timed out", action), err)
+		}
+		return NewNetworkError(fmt.Sprintf("%s request failed", action), err)
+	}
+	defer resp.Body.Close()
+
+	if !isSuccessStatus(resp.StatusCode) {
+		// qBittorrent explains rejected actions (e.g. 409 Conflict) in the body
+		body, _ := io.ReadAll(resp.Body)
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return WrapHTTPError(resp, fmt.Errorf("%s", msg))
+		}
+		return WrapHTTPError(resp, nil)
+	}
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return NewServerError(0, fmt.Sprintf("failed to decode %s response", action), err)
+		}
+	}
+
+	return nil
+}
+
 func (c *Client) get(ctx context.Context, endpoint string, v interface{}) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+endpoint, nil)
 	if err != nil {
@@ -500,7 +1205,7 @@ func (c *Client) get(ctx context.Context, endpoint string, v interface{}) error
 
 	req.Header.Set("Referer", c.baseURL)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		// Check if it's a timeout or context cancellation
 		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
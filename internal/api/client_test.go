@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -304,6 +307,133 @@ func TestClientLogin(t *testing.T) {
 	}
 }
 
+// expiringSessionServer is a qBittorrent stand-in that issues session
+// cookies on login and can expire them, as a server restart would
+type expiringSessionServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	password string
+	sessions map[string]bool
+	logins   int
+	paused   []string
+}
+
+func newExpiringSessionServer(t *testing.T) *expiringSessionServer {
+	s := &expiringSessionServer{password: "password", sessions: map[string]bool{}}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.URL.Path == "/api/v2/auth/login" {
+			if r.FormValue("password") != s.password {
+				fmt.Fprint(w, "Fails.")
+				return
+			}
+			s.logins++
+			sid := fmt.Sprintf("session-%d", s.logins)
+			s.sessions[sid] = true
+			http.SetCookie(w, &http.Cookie{Name: "SID", Value: sid, Path: "/"})
+			fmt.Fprint(w, "Ok.")
+			return
+		}
+
+		if cookie, err := r.Cookie("SID"); err != nil || !s.sessions[cookie.Value] {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "Forbidden")
+			return
+		}
+
+		switch r.URL.Path {
+		case "/api/v2/torrents/info":
+			fmt.Fprint(w, `[{"hash":"abc123","name":"Ubuntu"}]`)
+		case "/api/v2/torrents/stop":
+			s.paused = append(s.paused, r.FormValue("hashes"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// expire drops all sessions, optionally changing the password
+func (s *expiringSessionServer) expire(password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = map[string]bool{}
+	s.password = password
+}
+
+func TestClientReloginOnExpiredSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries reads and writes after logging in again", func(t *testing.T) {
+		server := newExpiringSessionServer(t)
+		client, err := NewClient(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, client.Login("admin", "password"))
+
+		server.expire("password")
+		torrents, err := client.GetTorrents(ctx)
+		require.NoError(t, err)
+		assert.Len(t, torrents, 1)
+		assert.Equal(t, 2, server.logins)
+
+		// The form body is sent again on the retry
+		server.expire("password")
+		require.NoError(t, client.PauseTorrents(ctx, []string{"abc123"}))
+		assert.Equal(t, []string{"abc123"}, server.paused)
+		assert.Equal(t, 3, server.logins)
+	})
+
+	t.Run("concurrent requests log in again once", func(t *testing.T) {
+		server := newExpiringSessionServer(t)
+		client, err := NewClient(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, client.Login("admin", "password"))
+
+		server.expire("password")
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Go(func() {
+				_, err := client.GetTorrents(ctx)
+				assert.NoError(t, err)
+			})
+		}
+		wg.Wait()
+		assert.Equal(t, 2, server.logins)
+	})
+
+	t.Run("auth error when logging in again fails", func(t *testing.T) {
+		server := newExpiringSessionServer(t)
+		client, err := NewClient(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, client.Login("admin", "password"))
+
+		server.expire("changed")
+		_, err = client.GetTorrents(ctx)
+		require.Error(t, err)
+		assert.True(t, IsAuthError(err))
+
+		err = client.PauseTorrents(ctx, []string{"abc123"})
+		require.Error(t, err)
+		assert.True(t, IsAuthError(err))
+		assert.Empty(t, server.paused)
+	})
+
+	t.Run("no login without stored credentials", func(t *testing.T) {
+		server := newExpiringSessionServer(t)
+		client, err := NewClient(server.URL)
+		require.NoError(t, err)
+
+		_, err = client.GetTorrents(ctx)
+		require.Error(t, err)
+		assert.True(t, IsAuthError(err))
+		assert.Equal(t, 0, server.logins)
+	})
+}
+
 func TestClientGetTorrents(t *testing.T) {
 	mockTorrents := []Torrent{
 		{
@@ -506,7 +636,7 @@ func TestClientActionEndpointsAcceptStatus(t *testing.T) {
 			return c.DeleteTorrents(ctx, []string{"hash1"}, false)
 		}},
 		{"AddTorrentURL", "/api/v2/torrents/add", func(c *Client, ctx context.Context) error {
-			return c.AddTorrentURL(ctx, "magnet:?xt=urn:btih:abc")
+			return c.AddTorrentURL(ctx, "magnet:?xt=urn:btih:abc", AddTorrentOptions{})
 		}},
 		{"SetTorrentLocation", "/api/v2/torrents/setLocation", func(c *Client, ctx context.Context) error {
 			return c.SetTorrentLocation(ctx, []string{"hash1"}, "/downloads")
@@ -627,6 +757,72 @@ func TestClientGetCategories(t *testing.T) {
 	assert.Len(t, categories, 2)
 	assert.Contains(t, categories, "movies")
 	assert.Contains(t, categories, "tv")
+	assert.Equal(t, "/downloads/movies", categories["movies"].SavePath)
+}
+
+// formActionCase is an action endpoint call that posts form fields
+type formActionCase struct {
+	name   string
+	path   string
+	fields map[string]string
+	call   func(c *Client, ctx context.Context) error
+}
+
+// runFormActionCases checks that each call posts its fields to its endpoint,
+// and that the server's explanation of a rejected action is surfaced
+func runFormActionCases(t *testing.T, cases []formActionCase) {
+	t.Helper()
+	for _, action := range cases {
+		t.Run(action.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, action.path, r.URL.Path)
+				assert.Equal(t, "POST", r.Method)
+				require.NoError(t, r.ParseForm())
+				for field, want := range action.fields {
+					assert.Equal(t, want, r.PostForm.Get(field), "form field %s", field)
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			require.NoError(t, err)
+
+			assert.NoError(t, action.call(client, context.Background()))
+		})
+
+		t.Run(action.name+"/rejected", func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte("Rejected by qBittorrent"))
+			}))
+			defer server.Close()
+
+			client, err := NewClient(server.URL)
+			require.NoError(t, err)
+
+			err = action.call(client, context.Background())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "Rejected by qBittorrent", "server message should be surfaced")
+		})
+	}
+}
+
+func TestClientCategoryActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"CreateCategory", "/api/v2/torrents/createCategory", map[string]string{"category": "movies", "savePath": "/data/movies"}, func(c *Client, ctx context.Context) error {
+			return c.CreateCategory(ctx, "movies", "/data/movies")
+		}},
+		{"EditCategory", "/api/v2/torrents/editCategory", map[string]string{"category": "movies", "savePath": "/data/films"}, func(c *Client, ctx context.Context) error {
+			return c.EditCategory(ctx, "movies", "/data/films")
+		}},
+		{"RemoveCategories", "/api/v2/torrents/removeCategories", map[string]string{"categories": "movies\ntv"}, func(c *Client, ctx context.Context) error {
+			return c.RemoveCategories(ctx, []string{"movies", "tv"})
+		}},
+		{"SetCategory", "/api/v2/torrents/setCategory", map[string]string{"hashes": "hash1|hash2", "category": "tv"}, func(c *Client, ctx context.Context) error {
+			return c.SetCategory(ctx, []string{"hash1", "hash2"}, "tv")
+		}},
+	})
 }
 
 func TestClientGetTags(t *testing.T) {
@@ -648,6 +844,165 @@ func TestClientGetTags(t *testing.T) {
 	assert.Equal(t, mockTags, tags)
 }
 
+func TestClientTagActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"AddTags", "/api/v2/torrents/addTags", map[string]string{"hashes": "hash1|hash2", "tags": "hd,favorite"}, func(c *Client, ctx context.Context) error {
+			return c.AddTags(ctx, []string{"hash1", "hash2"}, []string{"hd", "favorite"})
+		}},
+		{"RemoveTags", "/api/v2/torrents/removeTags", map[string]string{"hashes": "hash1", "tags": "hd"}, func(c *Client, ctx context.Context) error {
+			return c.RemoveTags(ctx, []string{"hash1"}, []string{"hd"})
+		}},
+		{"CreateTags", "/api/v2/torrents/createTags", map[string]string{"tags": "new,other"}, func(c *Client, ctx context.Context) error {
+			return c.CreateTags(ctx, []string{"new", "other"})
+		}},
+		{"DeleteTags", "/api/v2/torrents/deleteTags", map[string]string{"tags": "old"}, func(c *Client, ctx context.Context) error {
+			return c.DeleteTags(ctx, []string{"old"})
+		}},
+	})
+}
+
+func TestClientSetFilePriority(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/torrents/filePrio", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "abc123", r.PostForm.Get("hash"))
+		assert.Equal(t, "0|2|5", r.PostForm.Get("id"))
+		assert.Equal(t, "7", r.PostForm.Get("priority"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+
+	err = client.SetFilePriority(context.Background(), "abc123", []int{0, 2, 5}, FilePriorityMaximum)
+	assert.NoError(t, err)
+}
+
+func TestClientSpeedLimitActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"SetTorrentDownloadLimit", "/api/v2/torrents/setDownloadLimit", map[string]string{"hashes": "a|b", "limit": "1048576"}, func(c *Client, ctx context.Context) error {
+			return c.SetTorrentDownloadLimit(ctx, []string{"a", "b"}, 1048576)
+		}},
+		{"SetTorrentUploadLimit", "/api/v2/torrents/setUploadLimit", map[string]string{"hashes": "a", "limit": "0"}, func(c *Client, ctx context.Context) error {
+			return c.SetTorrentUploadLimit(ctx, []string{"a"}, 0)
+		}},
+		{"SetGlobalDownloadLimit", "/api/v2/transfer/setDownloadLimit", map[string]string{"limit": "512000"}, func(c *Client, ctx context.Context) error {
+			return c.SetGlobalDownloadLimit(ctx, 512000)
+		}},
+		{"SetGlobalUploadLimit", "/api/v2/transfer/setUploadLimit", map[string]string{"limit": "2048"}, func(c *Client, ctx context.Context) error {
+			return c.SetGlobalUploadLimit(ctx, 2048)
+		}},
+		{"SetShareLimits", "/api/v2/torrents/setShareLimits", map[string]string{"hashes": "a|b", "ratioLimit": "1.5", "seedingTimeLimit": "-2", "inactiveSeedingTimeLimit": "-1"}, func(c *Client, ctx context.Context) error {
+			return c.SetShareLimits(ctx, []string{"a", "b"}, ShareLimits{RatioLimit: 1.5, SeedingTimeLimit: ShareLimitGlobal, InactiveSeedingTimeLimit: ShareLimitUnlimited})
+		}},
+	})
+}
+
+func TestClientAltSpeedLimits(t *testing.T) {
+	altSpeed := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/transfer/toggleSpeedLimitsMode":
+			assert.Equal(t, "POST", r.Method)
+			altSpeed = !altSpeed
+		case "/api/v2/transfer/speedLimitsMode":
+			if altSpeed {
+				w.Write([]byte("1"))
+			} else {
+				w.Write([]byte("0"))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	enabled, err := client.GetAltSpeedLimitsMode(ctx)
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	require.NoError(t, client.ToggleAltSpeedLimits(ctx))
+	enabled, err = client.GetAltSpeedLimitsMode(ctx)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestClientTorrentControlActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"RecheckTorrents", "/api/v2/torrents/recheck", map[string]string{"hashes": "a|b"}, func(c *Client, ctx context.Context) error {
+			return c.RecheckTorrents(ctx, []string{"a", "b"})
+		}},
+		{"ReannounceTorrents", "/api/v2/torrents/reannounce", map[string]string{"hashes": "a"}, func(c *Client, ctx context.Context) error {
+			return c.ReannounceTorrents(ctx, []string{"a"})
+		}},
+		{"ToggleSequentialDownload", "/api/v2/torrents/toggleSequentialDownload", map[string]string{"hashes": "a|b"}, func(c *Client, ctx context.Context) error {
+			return c.ToggleSequentialDownload(ctx, []string{"a", "b"})
+		}},
+		{"ToggleFirstLastPiecePrio", "/api/v2/torrents/toggleFirstLastPiecePrio", map[string]string{"hashes": "a"}, func(c *Client, ctx context.Context) error {
+			return c.ToggleFirstLastPiecePrio(ctx, []string{"a"})
+		}},
+		{"IncreasePriority", "/api/v2/torrents/increasePrio", map[string]string{"hashes": "a|b"}, func(c *Client, ctx context.Context) error {
+			return c.IncreasePriority(ctx, []string{"a", "b"})
+		}},
+		{"DecreasePriority", "/api/v2/torrents/decreasePrio", map[string]string{"hashes": "a"}, func(c *Client, ctx context.Context) error {
+			return c.DecreasePriority(ctx, []string{"a"})
+		}},
+		{"TopPriority", "/api/v2/torrents/topPrio", map[string]string{"hashes": "a"}, func(c *Client, ctx context.Context) error {
+			return c.TopPriority(ctx, []string{"a"})
+		}},
+		{"BottomPriority", "/api/v2/torrents/bottomPrio", map[string]string{"hashes": "a"}, func(c *Client, ctx context.Context) error {
+			return c.BottomPriority(ctx, []string{"a"})
+		}},
+		{"SetForceStart", "/api/v2/torrents/setForceStart", map[string]string{"hashes": "a|b", "value": "true"}, func(c *Client, ctx context.Context) error {
+			return c.SetForceStart(ctx, []string{"a", "b"}, true)
+		}},
+	})
+}
+
+func TestClientTrackerActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"AddTrackers", "/api/v2/torrents/addTrackers", map[string]string{"hash": "abc", "urls": "udp://a.example:80/announce\nhttps://b.example/announce"}, func(c *Client, ctx context.Context) error {
+			return c.AddTrackers(ctx, "abc", []string{"udp://a.example:80/announce", "https://b.example/announce"})
+		}},
+		{"EditTracker", "/api/v2/torrents/editTracker", map[string]string{"hash": "abc", "origUrl": "https://old.example/announce", "newUrl": "https://new.example/announce"}, func(c *Client, ctx context.Context) error {
+			return c.EditTracker(ctx, "abc", "https://old.example/announce", "https://new.example/announce")
+		}},
+		{"RemoveTrackers", "/api/v2/torrents/removeTrackers", map[string]string{"hash": "abc", "urls": "https://a.example/announce|https://b.example/announce"}, func(c *Client, ctx context.Context) error {
+			return c.RemoveTrackers(ctx, "abc", []string{"https://a.example/announce", "https://b.example/announce"})
+		}},
+	})
+}
+
+func TestClientPeerActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"AddPeers", "/api/v2/torrents/addPeers", map[string]string{"hashes": "abc|def", "peers": "10.0.0.1:6881|[2001:db8::1]:51413"}, func(c *Client, ctx context.Context) error {
+			return c.AddPeers(ctx, []string{"abc", "def"}, []string{"10.0.0.1:6881", "[2001:db8::1]:51413"})
+		}},
+		{"BanPeers", "/api/v2/transfer/banPeers", map[string]string{"peers": "10.0.0.1:6881|10.0.0.2:6882"}, func(c *Client, ctx context.Context) error {
+			return c.BanPeers(ctx, []string{"10.0.0.1:6881", "10.0.0.2:6882"})
+		}},
+	})
+}
+
+func TestClientRenameActions(t *testing.T) {
+	runFormActionCases(t, []formActionCase{
+		{"RenameTorrent", "/api/v2/torrents/rename", map[string]string{"hash": "abc", "name": "New Name"}, func(c *Client, ctx context.Context) error {
+			return c.RenameTorrent(ctx, "abc", "New Name")
+		}},
+		{"RenameFile", "/api/v2/torrents/renameFile", map[string]string{"hash": "abc", "oldPath": "dir/a.mkv", "newPath": "dir/b.mkv"}, func(c *Client, ctx context.Context) error {
+			return c.RenameFile(ctx, "abc", "dir/a.mkv", "dir/b.mkv")
+		}},
+		{"RenameFolder", "/api/v2/torrents/renameFolder", map[string]string{"hash": "abc", "oldPath": "dir", "newPath": "folder"}, func(c *Client, ctx context.Context) error {
+			return c.RenameFolder(ctx, "abc", "dir", "folder")
+		}},
+	})
+}
+
 func TestTorrentStateHelpers(t *testing.T) {
 	tests := []struct {
 		state         TorrentState
@@ -711,7 +1066,7 @@ func TestMockClient(t *testing.T) {
 	assert.NotNil(t, stats)
 
 	// Test GetCategories
-	mock.Categories["test"] = map[string]interface{}{"name": "test"}
+	mock.Categories["test"] = Category{Name: "test"}
 	categories, err := mock.GetCategories(ctx)
 	assert.NoError(t, err)
 	assert.Contains(t, categories, "test")
@@ -790,6 +1145,161 @@ func TestTorrentControlMethods(t *testing.T) {
 	assert.Contains(t, err.Error(), "authentication required")
 }
 
+func TestMockTagMethods(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Tags = []string{"hd"}
+	mock.Torrents = []Torrent{
+		{Hash: "hash1", Tags: "hd"},
+		{Hash: "hash2", Tags: ""},
+	}
+	ctx := context.Background()
+
+	// AddTags creates unknown tags and skips duplicates
+	err := mock.AddTags(ctx, []string{"hash1", "hash2"}, []string{"hd", "4k"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hd", "4k"}, mock.Tags)
+	assert.Equal(t, "hd, 4k", mock.Torrents[0].Tags)
+	assert.Equal(t, "hd, 4k", mock.Torrents[1].Tags)
+
+	// RemoveTags only affects the given torrents
+	err = mock.RemoveTags(ctx, []string{"hash1"}, []string{"hd"})
+	assert.NoError(t, err)
+	assert.Equal(t, "4k", mock.Torrents[0].Tags)
+	assert.Equal(t, "hd, 4k", mock.Torrents[1].Tags)
+
+	// CreateTags adds to the global list without touching torrents
+	err = mock.CreateTags(ctx, []string{"favorite"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hd", "4k", "favorite"}, mock.Tags)
+
+	// DeleteTags removes from the global list and every torrent
+	err = mock.DeleteTags(ctx, []string{"4k"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hd", "favorite"}, mock.Tags)
+	assert.Equal(t, "", mock.Torrents[0].Tags)
+	assert.Equal(t, "hd", mock.Torrents[1].Tags)
+
+	// Test authentication required
+	mock.LoggedIn = false
+	err = mock.AddTags(ctx, []string{"hash1"}, []string{"hd"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "authentication required")
+}
+
+func TestMockSetFilePriority(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Files["hash1"] = []TorrentFile{
+		{Index: 0, Name: "a.mkv", Priority: FilePriorityNormal},
+		{Index: 1, Name: "b.mkv", Priority: FilePriorityNormal},
+	}
+	ctx := context.Background()
+
+	err := mock.SetFilePriority(ctx, "hash1", []int{1}, FilePrioritySkip)
+	assert.NoError(t, err)
+	assert.Equal(t, FilePriorityNormal, mock.Files["hash1"][0].Priority)
+	assert.Equal(t, FilePrioritySkip, mock.Files["hash1"][1].Priority)
+
+	mock.LoggedIn = false
+	err = mock.SetFilePriority(ctx, "hash1", []int{0}, FilePrioritySkip)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "authentication required")
+}
+
+func TestMockRenameMethods(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []Torrent{{Hash: "hash1", Name: "old"}}
+	mock.Files["hash1"] = []TorrentFile{
+		{Index: 0, Name: "Pack/S01/E01.mkv"},
+		{Index: 1, Name: "Pack/S01/E02.mkv"},
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, mock.RenameTorrent(ctx, "hash1", "new"))
+	assert.Equal(t, "new", mock.Torrents[0].Name)
+
+	assert.NoError(t, mock.RenameFile(ctx, "hash1", "Pack/S01/E01.mkv", "Pack/S01/Pilot.mkv"))
+	assert.Equal(t, "Pack/S01/Pilot.mkv", mock.Files["hash1"][0].Name)
+
+	assert.NoError(t, mock.RenameFolder(ctx, "hash1", "Pack/S01", "Pack/Season 1"))
+	assert.Equal(t, "Pack/Season 1/Pilot.mkv", mock.Files["hash1"][0].Name)
+	assert.Equal(t, "Pack/Season 1/E02.mkv", mock.Files["hash1"][1].Name)
+
+	assert.Error(t, mock.RenameFile(ctx, "hash1", "missing.mkv", "other.mkv"))
+	assert.Error(t, mock.RenameFolder(ctx, "hash1", "Pack/S01", "Pack/S02"))
+}
+
+func TestMockQueueMethods(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []Torrent{
+		{Hash: "a", Priority: 1},
+		{Hash: "b", Priority: 2},
+		{Hash: "c", Priority: 3},
+		{Hash: "seed", Priority: 0}, // Not queued
+	}
+	ctx := context.Background()
+	positions := func() []int {
+		return []int{mock.Torrents[0].Priority, mock.Torrents[1].Priority, mock.Torrents[2].Priority, mock.Torrents[3].Priority}
+	}
+
+	assert.NoError(t, mock.IncreasePriority(ctx, []string{"c"}))
+	assert.Equal(t, []int{1, 3, 2, 0}, positions())
+
+	assert.NoError(t, mock.TopPriority(ctx, []string{"b", "seed"}))
+	assert.Equal(t, []int{2, 1, 3, 0}, positions())
+
+	assert.NoError(t, mock.BottomPriority(ctx, []string{"b"}))
+	assert.Equal(t, []int{1, 3, 2, 0}, positions())
+
+	assert.NoError(t, mock.DecreasePriority(ctx, []string{"a"}))
+	assert.Equal(t, []int{2, 3, 1, 0}, positions())
+
+	// Moving the first torrent up is a no-op
+	assert.NoError(t, mock.IncreasePriority(ctx, []string{"c"}))
+	assert.Equal(t, []int{2, 3, 1, 0}, positions())
+}
+
+func TestMockTrackerMethods(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Trackers["hash1"] = []Tracker{{URL: "** [DHT] **"}, {URL: "https://old.example/announce"}}
+	ctx := context.Background()
+
+	assert.NoError(t, mock.AddTrackers(ctx, "hash1", []string{"udp://backup.example:80"}))
+	assert.Len(t, mock.Trackers["hash1"], 3)
+
+	assert.NoError(t, mock.EditTracker(ctx, "hash1", "https://old.example/announce", "https://new.example/announce"))
+	assert.Equal(t, "https://new.example/announce", mock.Trackers["hash1"][1].URL)
+	assert.Error(t, mock.EditTracker(ctx, "hash1", "https://new.example/announce", "udp://backup.example:80"), "duplicate URL")
+	assert.Error(t, mock.EditTracker(ctx, "hash1", "https://missing.example", "https://other.example"), "missing URL")
+
+	assert.NoError(t, mock.RemoveTrackers(ctx, "hash1", []string{"udp://backup.example:80"}))
+	assert.Len(t, mock.Trackers["hash1"], 2)
+
+	assert.True(t, mock.Trackers["hash1"][0].IsPseudoTracker())
+	assert.False(t, mock.Trackers["hash1"][1].IsPseudoTracker())
+}
+
+func TestMockPeerMethods(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	ctx := context.Background()
+
+	assert.NoError(t, mock.AddPeers(ctx, []string{"hash1", "hash2"}, []string{"10.0.0.1:6881", "[2001:db8::1]:51413"}))
+	assert.Len(t, mock.Peers["hash1"], 2)
+	assert.Equal(t, Peer{IP: "2001:db8::1", Port: 51413, Connection: "BT"}, mock.Peers["hash2"]["[2001:db8::1]:51413"])
+	assert.Error(t, mock.AddPeers(ctx, []string{"hash1"}, []string{"10.0.0.1"}), "missing port")
+
+	// Banning disconnects the peer from every torrent
+	assert.NoError(t, mock.BanPeers(ctx, []string{"10.0.0.1:6881"}))
+	assert.NotContains(t, mock.Peers["hash1"], "10.0.0.1:6881")
+	assert.NotContains(t, mock.Peers["hash2"], "10.0.0.1:6881")
+	assert.Equal(t, []string{"10.0.0.1:6881"}, mock.BannedPeers)
+}
+
 func TestGetDirectoryContent(t *testing.T) {
 	mock := NewMockClient()
 	mock.LoggedIn = true
@@ -818,8 +1328,8 @@ func TestSyncMainData(t *testing.T) {
 	mock.LoggedIn = true
 	mock.Torrents = GenerateMockTorrents(3)
 	mock.Tags = []string{"test", "hd"}
-	mock.Categories = map[string]interface{}{
-		"movies": map[string]interface{}{"name": "Movies", "savePath": "/downloads/movies"},
+	mock.Categories = map[string]Category{
+		"movies": {Name: "movies", SavePath: "/downloads/movies"},
 	}
 	ctx := context.Background()
 
@@ -840,6 +1350,18 @@ func TestSyncMainData(t *testing.T) {
 	assert.NotNil(t, syncData2)
 	assert.False(t, syncData2.FullUpdate, "Subsequent request should be incremental")
 	assert.Equal(t, 2, syncData2.RID, "RID should be incremented again")
+	assert.Empty(t, syncData2.Categories, "Unchanged categories should not be resent")
+	assert.Empty(t, syncData2.Tags, "Unchanged tags should not be resent")
+
+	// Created and removed categories and tags are reported incrementally
+	mock.Categories["tv"] = Category{Name: "tv"}
+	mock.Tags = []string{"hd", "4k"}
+	syncData3, err := mock.SyncMainData(ctx, syncData2.RID)
+	assert.NoError(t, err)
+	assert.Contains(t, syncData3.Categories, "tv")
+	assert.Len(t, syncData3.Categories, 1)
+	assert.Equal(t, []string{"4k"}, syncData3.Tags)
+	assert.Equal(t, []string{"test"}, syncData3.TagsRemoved)
 
 	// Test authentication required
 	mock.LoggedIn = false
@@ -919,6 +1441,21 @@ func TestPartialTorrentApplyTo(t *testing.T) {
 		assert.Equal(t, 1.5, existing.Ratio)
 	})
 
+	t.Run("sequential and first/last piece flags", func(t *testing.T) {
+		existing := Torrent{Hash: "abc123", SeqDownload: true}
+
+		var partial PartialTorrent
+		require.NoError(t, json.Unmarshal([]byte(`{"seq_dl": false, "f_l_piece_prio": true}`), &partial))
+		partial.ApplyTo(&existing)
+
+		assert.False(t, existing.SeqDownload, "seq_dl=false should be applied")
+		assert.True(t, existing.FirstLastPiece)
+
+		// Absent flags are left alone
+		(&PartialTorrent{}).ApplyTo(&existing)
+		assert.True(t, existing.FirstLastPiece)
+	})
+
 	t.Run("ToTorrent creates torrent from partial", func(t *testing.T) {
 		name := "Test Torrent"
 		size := int64(1024)
@@ -944,3 +1481,558 @@ func TestPartialTorrentApplyTo(t *testing.T) {
 		assert.Equal(t, int64(0), torrent.DlSpeed)
 	})
 }
+
+func TestClientSyncTorrentPeers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/sync/torrentPeers", r.URL.Path)
+		assert.Equal(t, "abc123", r.URL.Query().Get("hash"))
+		assert.Equal(t, "7", r.URL.Query().Get("rid"))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"rid":8,"peers":{"10.0.0.1:6881":{"dl_speed":0,"progress":0.5}},"peers_removed":["10.0.0.2:6881"],"show_flags":true}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+
+	resp, err := client.SyncTorrentPeers(context.Background(), "abc123", 7)
+	require.NoError(t, err)
+	assert.Equal(t, 8, resp.RID)
+	assert.False(t, resp.FullUpdate)
+	assert.Equal(t, []string{"10.0.0.2:6881"}, resp.PeersRemoved)
+
+	partial := resp.Peers["10.0.0.1:6881"]
+	require.NotNil(t, partial.DlSpeed, "zero speed should be present")
+	assert.Equal(t, int64(0), *partial.DlSpeed)
+	assert.Nil(t, partial.Client, "unchanged fields should be absent")
+}
+
+func TestApplyPeerSync(t *testing.T) {
+	speed, client := int64(2048), "Deluge 2.1"
+	peers := map[string]Peer{
+		"10.0.0.1:6881": {IP: "10.0.0.1", Port: 6881, Client: "Transmission", DlSpeed: 1024},
+		"10.0.0.2:6881": {IP: "10.0.0.2", Port: 6881},
+	}
+
+	// Incremental updates merge changed fields and drop removed peers
+	peers = ApplyPeerSync(peers, &SyncTorrentPeersResponse{
+		Peers: map[string]PartialPeer{
+			"10.0.0.1:6881": {DlSpeed: &speed},
+			"10.0.0.3:6881": {Client: &client},
+		},
+		PeersRemoved: []string{"10.0.0.2:6881"},
+	})
+	assert.Len(t, peers, 2)
+	assert.Equal(t, Peer{IP: "10.0.0.1", Port: 6881, Client: "Transmission", DlSpeed: 2048}, peers["10.0.0.1:6881"])
+	assert.Equal(t, "Deluge 2.1", peers["10.0.0.3:6881"].Client)
+
+	// A full update replaces everything
+	peers = ApplyPeerSync(peers, &SyncTorrentPeersResponse{
+		FullUpdate: true,
+		Peers:      map[string]PartialPeer{"10.0.0.3:6881": {Client: &client}},
+	})
+	assert.Equal(t, map[string]Peer{"10.0.0.3:6881": {Client: "Deluge 2.1"}}, peers)
+}
+
+func TestMockSyncTorrentPeers(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Peers["hash1"] = map[string]Peer{
+		"10.0.0.1:6881": {IP: "10.0.0.1", Port: 6881},
+		"10.0.0.2:6881": {IP: "10.0.0.2", Port: 6881},
+	}
+	ctx := context.Background()
+
+	full, err := mock.SyncTorrentPeers(ctx, "hash1", 0)
+	require.NoError(t, err)
+	assert.True(t, full.FullUpdate)
+	assert.Len(t, full.Peers, 2)
+
+	// Nothing changed
+	resp, err := mock.SyncTorrentPeers(ctx, "hash1", full.RID)
+	require.NoError(t, err)
+	assert.False(t, resp.FullUpdate)
+	assert.Empty(t, resp.Peers)
+	assert.Empty(t, resp.PeersRemoved)
+
+	mock.Peers["hash1"]["10.0.0.1:6881"] = Peer{IP: "10.0.0.1", Port: 6881, DlSpeed: 100}
+	delete(mock.Peers["hash1"], "10.0.0.2:6881")
+	resp, err = mock.SyncTorrentPeers(ctx, "hash1", resp.RID)
+	require.NoError(t, err)
+	assert.Len(t, resp.Peers, 1)
+	assert.Equal(t, []string{"10.0.0.2:6881"}, resp.PeersRemoved)
+
+	// A stale rid gets a full update
+	resp, err = mock.SyncTorrentPeers(ctx, "hash1", full.RID)
+	require.NoError(t, err)
+	assert.True(t, resp.FullUpdate)
+}
+
+func TestClientPreferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/app/preferences":
+			assert.Equal(t, "GET", r.Method)
+			w.Write([]byte(`{"save_path":"/data","listen_port":6881,"dht":true,"dl_limit":1048576,"max_ratio":1.5,"web_ui_port":8080}`))
+		case "/api/v2/app/setPreferences":
+			assert.Equal(t, "POST", r.Method)
+			require.NoError(t, r.ParseForm())
+			assert.JSONEq(t, `{"listen_port":51413,"dht":false}`, r.PostForm.Get("json"))
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	prefs, err := client.GetPreferences(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "/data", prefs.SavePath)
+	assert.Equal(t, int64(1048576), prefs.DlLimit)
+	assert.True(t, prefs.DHT)
+
+	assert.NoError(t, client.SetPreferences(ctx, map[string]any{"listen_port": 51413, "dht": false}))
+}
+
+func TestPreferencesChanges(t *testing.T) {
+	original := &Preferences{SavePath: "/data", ListenPort: 6881, DHT: true, DlLimit: 1 << 40, MaxRatio: 1.5}
+	edited := *original
+	assert.Empty(t, edited.Changes(original))
+
+	edited.ListenPort = 51413
+	edited.DHT = false
+	edited.DlLimit = 1<<40 + 1 // Large values must compare exactly
+	changes := edited.Changes(original)
+	assert.Len(t, changes, 3)
+	assert.Contains(t, changes, "listen_port")
+	assert.Contains(t, changes, "dht")
+	assert.Contains(t, changes, "dl_limit")
+}
+
+func TestMockPreferences(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	ctx := context.Background()
+
+	prefs, err := mock.GetPreferences(ctx)
+	require.NoError(t, err)
+	prefs.ListenPort = 1234
+	assert.NotEqual(t, 1234, mock.Preferences.ListenPort, "callers get a copy")
+
+	assert.NoError(t, mock.SetPreferences(ctx, prefs.Changes(mock.Preferences)))
+	assert.Equal(t, 1234, mock.Preferences.ListenPort)
+	assert.Equal(t, "/downloads", mock.Preferences.SavePath, "other keys are kept")
+}
+
+func TestClientRSS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/rss/items":
+			assert.Equal(t, "true", r.URL.Query().Get("withData"))
+			w.Write([]byte(`{
+				"Linux": {
+					"Debian": {"uid": "1", "url": "https://debian.example/rss", "title": "Debian", "articles": [
+						{"id": "a1", "title": "debian-13.iso", "torrentURL": "https://debian.example/13.torrent", "isRead": false},
+						{"id": "a2", "title": "debian-12.iso", "link": "https://debian.example/12.torrent", "isRead": true}
+					]}
+				},
+				"Arch": {"uid": "2", "url": "https://arch.example/rss", "title": "Arch", "isLoading": true}
+			}`))
+		case "/api/v2/rss/rules":
+			w.Write([]byte(`{"Debian ISOs": {"enabled": true, "mustContain": "debian iso", "affectedFeeds": ["https://debian.example/rss"], "torrentParams": {"stopped": true}}}`))
+		case "/api/v2/rss/matchingArticles":
+			assert.Equal(t, "Debian ISOs", r.URL.Query().Get("ruleName"))
+			w.Write([]byte(`{"Debian": ["debian-13.iso"]}`))
+		case "/api/v2/rss/addFeed":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "https://new.example/rss", r.PostForm.Get("url"))
+			assert.Equal(t, `Linux\New`, r.PostForm.Get("path"))
+		case "/api/v2/rss/removeItem":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "Arch", r.PostForm.Get("path"))
+		case "/api/v2/rss/refreshItem":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, `Linux\Debian`, r.PostForm.Get("itemPath"))
+		case "/api/v2/rss/markAsRead":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, `Linux\Debian`, r.PostForm.Get("itemPath"))
+			assert.Equal(t, "a1", r.PostForm.Get("articleId"))
+		case "/api/v2/rss/setRule":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "Debian ISOs", r.PostForm.Get("ruleName"))
+			var def map[string]any
+			require.NoError(t, json.Unmarshal([]byte(r.PostForm.Get("ruleDef")), &def))
+			assert.Equal(t, false, def["enabled"])
+			assert.Equal(t, map[string]any{"stopped": true}, def["torrentParams"], "unmodelled settings are sent back")
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	feeds, err := client.GetRSSItems(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, feeds, 2)
+	assert.Equal(t, "Arch", feeds[0].Path)
+	assert.True(t, feeds[0].IsLoading)
+	assert.Equal(t, `Linux\Debian`, feeds[1].Path)
+	assert.Equal(t, 1, feeds[1].UnreadCount())
+	assert.Equal(t, "https://debian.example/13.torrent", feeds[1].Articles[0].DownloadURL())
+	assert.Equal(t, "https://debian.example/12.torrent", feeds[1].Articles[1].DownloadURL())
+
+	rules, err := client.GetRSSRules(ctx)
+	require.NoError(t, err)
+	rule := rules["Debian ISOs"]
+	assert.True(t, rule.Enabled)
+	assert.Equal(t, []string{"https://debian.example/rss"}, rule.AffectedFeeds)
+
+	matches, err := client.GetRSSMatchingArticles(ctx, "Debian ISOs")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"Debian": {"debian-13.iso"}}, matches)
+
+	rule.Enabled = false
+	assert.NoError(t, client.SetRSSRule(ctx, "Debian ISOs", rule))
+	assert.NoError(t, client.AddRSSFeed(ctx, "https://new.example/rss", `Linux\New`))
+	assert.NoError(t, client.RemoveRSSItem(ctx, "Arch"))
+	assert.NoError(t, client.RefreshRSSItem(ctx, `Linux\Debian`))
+	assert.NoError(t, client.MarkRSSAsRead(ctx, `Linux\Debian`, "a1"))
+}
+
+func TestRSSRuleMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  RSSRule
+		title string
+		want  bool
+	}{
+		{"empty rule matches everything", RSSRule{}, "Anything", true},
+		{"all words must appear in any order", RSSRule{MustContain: "1080p show"}, "Show.S01E01.1080p", true},
+		{"missing word", RSSRule{MustContain: "show 2160p"}, "Show.S01E01.1080p", false},
+		{"alternatives", RSSRule{MustContain: "2160p|1080p"}, "Show.S01E01.1080p", true},
+		{"wildcards", RSSRule{MustContain: "show*e01"}, "Show.S01E01.1080p", true},
+		{"single character wildcard", RSSRule{MustContain: "s0?e02"}, "Show.S01E01.1080p", false},
+		{"excluded", RSSRule{MustContain: "show", MustNotContain: "720p|1080p"}, "Show.S01E01.1080p", false},
+		{"not excluded", RSSRule{MustContain: "show", MustNotContain: "720p"}, "Show.S01E01.1080p", true},
+		{"regex", RSSRule{UseRegex: true, MustContain: `s\d+e01`}, "Show.S01E01.1080p", true},
+		{"regex is not split on spaces", RSSRule{UseRegex: true, MustContain: "1080p show"}, "Show.S01E01.1080p", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.Matches(tt.title)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := RSSRule{UseRegex: true, MustContain: "(unclosed"}.Matches("title")
+	assert.Error(t, err)
+}
+
+func TestMockRSS(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	ctx := context.Background()
+
+	require.NoError(t, mock.AddRSSFeed(ctx, "https://b.example/rss", "B"))
+	require.NoError(t, mock.AddRSSFeed(ctx, "https://a.example/rss", `Folder\A`))
+	assert.Error(t, mock.AddRSSFeed(ctx, "https://a.example/rss", "Again"), "duplicate URL")
+	mock.RSSFeeds[1].Articles = []RSSArticle{{ID: "1", Title: "Show.S01E01.1080p"}, {ID: "2", Title: "Show.S01E01.720p"}}
+
+	feeds, err := mock.GetRSSItems(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, feeds, 2)
+	assert.Equal(t, "B", feeds[0].Path)
+	assert.Empty(t, feeds[1].Articles, "articles only with data")
+
+	require.NoError(t, mock.MarkRSSAsRead(ctx, "Folder", "1"))
+	assert.True(t, mock.RSSFeeds[1].Articles[0].IsRead)
+	assert.False(t, mock.RSSFeeds[1].Articles[1].IsRead)
+
+	require.NoError(t, mock.SetRSSRule(ctx, "Show", RSSRule{MustContain: "show 1080p", AffectedFeeds: []string{"https://a.example/rss"}}))
+	matches, err := mock.GetRSSMatchingArticles(ctx, "Show")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"A": {"Show.S01E01.1080p"}}, matches)
+
+	require.NoError(t, mock.RemoveRSSItem(ctx, "Folder"))
+	assert.Len(t, mock.RSSFeeds, 1)
+	assert.Error(t, mock.RemoveRSSItem(ctx, "Folder"))
+}
+
+func TestClientSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/search/start":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "debian iso", r.PostForm.Get("pattern"))
+			assert.Equal(t, "piratebay|eztv", r.PostForm.Get("plugins"))
+			assert.Equal(t, "software", r.PostForm.Get("category"))
+			w.Write([]byte(`{"id": 42}`))
+		case "/api/v2/search/status":
+			assert.Equal(t, "42", r.URL.Query().Get("id"))
+			w.Write([]byte(`[{"id": 42, "status": "Running", "total": 1}]`))
+		case "/api/v2/search/results":
+			assert.Equal(t, "42", r.URL.Query().Get("id"))
+			assert.Equal(t, "0", r.URL.Query().Get("limit"))
+			assert.Equal(t, "5", r.URL.Query().Get("offset"))
+			w.Write([]byte(`{"results": [{"fileName": "debian-13.iso", "fileUrl": "magnet:?xt=urn:btih:abc", "fileSize": 1024, "nbSeeders": 10, "nbLeechers": 2, "engineName": "piratebay"}], "status": "Stopped", "total": 6}`))
+		case "/api/v2/search/stop", "/api/v2/search/delete":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "42", r.PostForm.Get("id"))
+		case "/api/v2/search/plugins":
+			w.Write([]byte(`[
+				{"name": "piratebay", "fullName": "The Pirate Bay", "enabled": true, "supportedCategories": [{"id": "all", "name": "All categories"}, {"id": "software", "name": "Software"}]},
+				{"name": "legacy", "enabled": false, "supportedCategories": ["all", "movies"]}
+			]`))
+		case "/api/v2/search/enablePlugin":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "legacy", r.PostForm.Get("names"))
+			assert.Equal(t, "true", r.PostForm.Get("enable"))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	id, err := client.StartSearch(ctx, "debian iso", []string{"piratebay", "eztv"}, "software")
+	require.NoError(t, err)
+	assert.Equal(t, 42, id)
+
+	statuses, err := client.GetSearchStatus(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, []SearchStatus{{ID: 42, Status: SearchStatusRunning, Total: 1}}, statuses)
+
+	results, err := client.GetSearchResults(ctx, id, 0, 5)
+	require.NoError(t, err)
+	assert.Equal(t, SearchStatusStopped, results.Status)
+	require.Len(t, results.Results, 1)
+	assert.Equal(t, "magnet:?xt=urn:btih:abc", results.Results[0].FileURL)
+	assert.Equal(t, 10, results.Results[0].Seeders)
+	assert.Equal(t, "piratebay", results.Results[0].EngineName)
+
+	assert.NoError(t, client.StopSearch(ctx, id))
+	assert.NoError(t, client.DeleteSearch(ctx, id))
+
+	plugins, err := client.GetSearchPlugins(ctx)
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, SearchCategory{ID: "software", Name: "Software"}, plugins[0].SupportedCategories[1])
+	assert.Equal(t, SearchCategory{ID: "movies", Name: "movies"}, plugins[1].SupportedCategories[1], "older servers send plain names")
+
+	assert.NoError(t, client.EnableSearchPlugins(ctx, []string{"legacy"}, true))
+}
+
+func TestClientStartSearchDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "enabled", r.PostForm.Get("plugins"))
+		assert.Equal(t, "all", r.PostForm.Get("category"))
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	id, err := client.StartSearch(context.Background(), "ubuntu", nil, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, id)
+}
+
+func TestMockSearch(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.SearchPlugins = []SearchPlugin{{Name: "one", Enabled: true}, {Name: "two"}}
+	mock.SearchResults = []SearchResult{
+		{FileName: "Ubuntu 24.04 Desktop", EngineName: "one"},
+		{FileName: "Ubuntu 24.04 Server", EngineName: "one"},
+		{FileName: "Ubuntu 22.04 Desktop", EngineName: "two"},
+		{FileName: "Debian 13", EngineName: "one"},
+	}
+	ctx := context.Background()
+
+	_, err := mock.StartSearch(ctx, " ", nil, "")
+	assert.Error(t, err, "empty pattern")
+
+	id, err := mock.StartSearch(ctx, "ubuntu desktop", nil, "")
+	require.NoError(t, err)
+
+	// Results arrive over several polls
+	page, err := mock.GetSearchResults(ctx, id, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, SearchStatusStopped, page.Status, "both matches found in the first batch")
+	assert.Len(t, page.Results, 2)
+
+	id, err = mock.StartSearch(ctx, "ubuntu", []string{"one"}, "")
+	require.NoError(t, err)
+	page, err = mock.GetSearchResults(ctx, id, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, SearchStatusStopped, page.Status)
+	assert.Equal(t, 2, page.Total)
+	assert.Len(t, page.Results, 1, "limit applies")
+
+	id, err = mock.StartSearch(ctx, "ubuntu", nil, "")
+	require.NoError(t, err)
+	page, err = mock.GetSearchResults(ctx, id, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, SearchStatusRunning, page.Status)
+	require.NoError(t, mock.StopSearch(ctx, id))
+	page, err = mock.GetSearchResults(ctx, id, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, SearchStatusStopped, page.Status)
+	assert.Empty(t, page.Results, "stopped searches find nothing more")
+
+	require.NoError(t, mock.DeleteSearch(ctx, id))
+	_, err = mock.GetSearchResults(ctx, id, 0, 0)
+	assert.Error(t, err)
+
+	require.NoError(t, mock.EnableSearchPlugins(ctx, []string{"two"}, true))
+	assert.True(t, mock.SearchPlugins[1].Enabled)
+	assert.Error(t, mock.EnableSearchPlugins(ctx, []string{"missing"}, true))
+}
+
+func TestClientLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/api/v2/log/main":
+			assert.Equal(t, "41", query.Get("last_known_id"))
+			assert.Equal(t, "false", query.Get("normal"))
+			assert.Equal(t, "false", query.Get("info"))
+			assert.Equal(t, "true", query.Get("warning"))
+			assert.Equal(t, "true", query.Get("critical"))
+			w.Write([]byte(`[{"id": 42, "message": "Failed to listen on IP", "timestamp": 1760000000123, "type": 8}]`))
+		case "/api/v2/log/peers":
+			assert.Equal(t, "-1", query.Get("last_known_id"))
+			w.Write([]byte(`[{"id": 0, "ip": "10.0.0.1", "timestamp": 1760000000000, "blocked": true, "reason": "IP filter"}]`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	messages, err := client.GetLog(ctx, LogWarning|LogCritical, 41)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, LogCritical, messages[0].Type)
+	assert.Equal(t, "critical", messages[0].Type.String())
+	assert.Equal(t, int64(1760000000123), messages[0].Time().UnixMilli())
+
+	entries, err := client.GetPeerLog(ctx, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []PeerLogEntry{{ID: 0, IP: "10.0.0.1", Timestamp: 1760000000000, Blocked: true, Reason: "IP filter"}}, entries)
+}
+
+func TestMockLog(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	mock.Log = []LogMessage{
+		{ID: 0, Message: "qBittorrent started", Type: LogNormal},
+		{ID: 1, Message: "Tracker unreachable", Type: LogWarning},
+		{ID: 2, Message: "Disk full", Type: LogCritical},
+	}
+	mock.PeerLog = []PeerLogEntry{{ID: 0, IP: "10.0.0.1"}, {ID: 1, IP: "10.0.0.2"}}
+	ctx := context.Background()
+
+	messages, err := mock.GetLog(ctx, LogAll, -1)
+	require.NoError(t, err)
+	assert.Len(t, messages, 3)
+
+	messages, err = mock.GetLog(ctx, LogAll, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []LogMessage{mock.Log[2]}, messages, "only messages after the last known ID")
+
+	messages, err = mock.GetLog(ctx, LogNormal|LogWarning, -1)
+	require.NoError(t, err)
+	assert.Len(t, messages, 2, "severities filter")
+
+	entries, err := mock.GetPeerLog(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []PeerLogEntry{mock.PeerLog[1]}, entries)
+}
+
+func TestClientAddTorrentOptions(t *testing.T) {
+	var forms []*multipart.Form
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/torrents/add", r.URL.Path)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		forms = append(forms, r.MultipartForm)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	torrentFile := filepath.Join(t.TempDir(), "debian.torrent")
+	require.NoError(t, os.WriteFile(torrentFile, []byte("d4:infod4:name6:debianee"), 0o600))
+
+	opts := AddTorrentOptions{
+		SavePath:      "/downloads/iso",
+		Category:      "linux",
+		Tags:          []string{"iso", "debian"},
+		Paused:        true,
+		SkipChecking:  true,
+		Sequential:    true,
+		ContentLayout: ContentLayoutNoSubfolder,
+		Rename:        "Debian 13",
+		Limits:        &ShareLimits{RatioLimit: 2.5, SeedingTimeLimit: ShareLimitUnlimited, InactiveSeedingTimeLimit: ShareLimitGlobal},
+	}
+	require.NoError(t, client.AddTorrentFile(ctx, torrentFile, opts))
+	require.NoError(t, client.AddTorrentURL(ctx, "magnet:?xt=urn:btih:abc", AddTorrentOptions{}))
+	require.Len(t, forms, 2)
+
+	fields := forms[0].Value
+	require.Len(t, forms[0].File["torrents"], 1)
+	assert.Equal(t, "debian.torrent", forms[0].File["torrents"][0].Filename)
+	for name, want := range map[string]string{
+		"savepath":                 "/downloads/iso",
+		"category":                 "linux",
+		"tags":                     "iso,debian",
+		"paused":                   "true",
+		"stopped":                  "true",
+		"skip_checking":            "true",
+		"sequentialDownload":       "true",
+		"contentLayout":            "NoSubfolder",
+		"rename":                   "Debian 13",
+		"ratioLimit":               "2.5",
+		"seedingTimeLimit":         "-1",
+		"inactiveSeedingTimeLimit": "-2",
+	} {
+		assert.Equal(t, []string{want}, fields[name], name)
+	}
+
+	// Without options only the URL is sent, keeping the server defaults
+	assert.Equal(t, map[string][]string{"urls": {"magnet:?xt=urn:btih:abc"}}, forms[1].Value)
+}
+
+func TestMockAddTorrentOptions(t *testing.T) {
+	mock := NewMockClient()
+	mock.LoggedIn = true
+	ctx := context.Background()
+
+	err := mock.AddTorrentURL(ctx, "magnet:?xt=urn:btih:abc", AddTorrentOptions{ContentLayout: "Flat"})
+	assert.Error(t, err)
+	assert.Empty(t, mock.AddedURLs)
+
+	opts := AddTorrentOptions{Category: "linux", Tags: []string{"iso"}, Paused: true}
+	require.NoError(t, mock.AddTorrentFile(ctx, "/tmp/debian.torrent", opts))
+	assert.Equal(t, []string{"/tmp/debian.torrent"}, mock.AddedFiles)
+	assert.Equal(t, []AddTorrentOptions{opts}, mock.AddOptions)
+	assert.Contains(t, mock.Categories, "linux", "missing categories are created")
+	assert.Contains(t, mock.Tags, "iso", "missing tags are created")
+}
@@ -60,6 +60,14 @@ func IsServerError(err error) bool {
 	return false
 }
 
+// IsTimeoutError returns true if the error is a timeout error
+func IsTimeoutError(err error) bool {
+	if apiErr, ok := err.(*APIError); ok {
+		return apiErr.Type == ErrorTypeTimeout
+	}
+	return false
+}
+
 // NewAuthError creates a new authentication error
 func NewAuthError(message string, cause error) *APIError {
 	return &APIError{
@@ -16,19 +16,98 @@ type ClientInterface interface {
 	// Torrent details
 	GetTorrentTrackers(ctx context.Context, hash string) ([]Tracker, error)
 	GetTorrentPeers(ctx context.Context, hash string) (map[string]Peer, error)
+	SyncTorrentPeers(ctx context.Context, hash string, rid int) (*SyncTorrentPeersResponse, error)
 	GetTorrentFiles(ctx context.Context, hash string) ([]TorrentFile, error)
 
 	// Torrent control
 	PauseTorrents(ctx context.Context, hashes []string) error
 	ResumeTorrents(ctx context.Context, hashes []string) error
 	DeleteTorrents(ctx context.Context, hashes []string, deleteFiles bool) error
-	AddTorrentFile(ctx context.Context, filePath string) error
-	AddTorrentURL(ctx context.Context, url string) error
+	AddTorrentFile(ctx context.Context, filePath string, opts AddTorrentOptions) error
+	AddTorrentURL(ctx context.Context, url string, opts AddTorrentOptions) error
 	SetTorrentLocation(ctx context.Context, hashes []string, newLocation string) error
+	RenameTorrent(ctx context.Context, hash, name string) error
+	RecheckTorrents(ctx context.Context, hashes []string) error
+	ReannounceTorrents(ctx context.Context, hashes []string) error
+	SetForceStart(ctx context.Context, hashes []string, enabled bool) error
+	ToggleSequentialDownload(ctx context.Context, hashes []string) error
+	ToggleFirstLastPiecePrio(ctx context.Context, hashes []string) error
+
+	// Queue, only available when queueing is enabled on the server
+	IncreasePriority(ctx context.Context, hashes []string) error
+	DecreasePriority(ctx context.Context, hashes []string) error
+	TopPriority(ctx context.Context, hashes []string) error
+	BottomPriority(ctx context.Context, hashes []string) error
+
+	// Trackers
+	AddTrackers(ctx context.Context, hash string, urls []string) error
+	EditTracker(ctx context.Context, hash, origURL, newURL string) error
+	RemoveTrackers(ctx context.Context, hash string, urls []string) error
+
+	// Peers, as "ip:port" addresses
+	AddPeers(ctx context.Context, hashes []string, peers []string) error
+	BanPeers(ctx context.Context, peers []string) error
+
+	// Speed limits, in bytes/s with 0 meaning unlimited
+	SetTorrentDownloadLimit(ctx context.Context, hashes []string, limit int64) error
+	SetTorrentUploadLimit(ctx context.Context, hashes []string, limit int64) error
+	SetGlobalDownloadLimit(ctx context.Context, limit int64) error
+	SetGlobalUploadLimit(ctx context.Context, limit int64) error
+	ToggleAltSpeedLimits(ctx context.Context) error
+	GetAltSpeedLimitsMode(ctx context.Context) (bool, error)
+
+	// Application preferences
+	GetPreferences(ctx context.Context) (*Preferences, error)
+	SetPreferences(ctx context.Context, changes map[string]any) error
+
+	// RSS feeds, addressed by their path in the feed tree
+	GetRSSItems(ctx context.Context, withData bool) ([]RSSFeed, error)
+	AddRSSFeed(ctx context.Context, feedURL, path string) error
+	RemoveRSSItem(ctx context.Context, path string) error
+	RefreshRSSItem(ctx context.Context, path string) error
+	MarkRSSAsRead(ctx context.Context, path, articleID string) error
+
+	// RSS auto-download rules
+	GetRSSRules(ctx context.Context) (map[string]RSSRule, error)
+	SetRSSRule(ctx context.Context, name string, rule RSSRule) error
+	GetRSSMatchingArticles(ctx context.Context, ruleName string) (map[string][]string, error)
+
+	// Search, through the search plugins installed on the server
+	StartSearch(ctx context.Context, pattern string, plugins []string, category string) (int, error)
+	GetSearchStatus(ctx context.Context, id int) ([]SearchStatus, error)
+	GetSearchResults(ctx context.Context, id, limit, offset int) (*SearchResults, error)
+	StopSearch(ctx context.Context, id int) error
+	DeleteSearch(ctx context.Context, id int) error
+	GetSearchPlugins(ctx context.Context) ([]SearchPlugin, error)
+	EnableSearchPlugins(ctx context.Context, names []string, enable bool) error
+
+	// Server logs, fetched incrementally after the last ID seen (-1 for all)
+	GetLog(ctx context.Context, types LogType, lastKnownID int) ([]LogMessage, error)
+	GetPeerLog(ctx context.Context, lastKnownID int) ([]PeerLogEntry, error)
+
+	// Share limits
+	SetShareLimits(ctx context.Context, hashes []string, limits ShareLimits) error
+
+	// Files
+	SetFilePriority(ctx context.Context, hash string, fileIDs []int, priority int) error
+	RenameFile(ctx context.Context, hash, oldPath, newPath string) error
+	RenameFolder(ctx context.Context, hash, oldPath, newPath string) error
+
+	// Categories
+	CreateCategory(ctx context.Context, name, savePath string) error
+	EditCategory(ctx context.Context, name, savePath string) error
+	RemoveCategories(ctx context.Context, names []string) error
+	SetCategory(ctx context.Context, hashes []string, category string) error
+
+	// Tags
+	AddTags(ctx context.Context, hashes []string, tags []string) error
+	RemoveTags(ctx context.Context, hashes []string, tags []string) error
+	CreateTags(ctx context.Context, tags []string) error
+	DeleteTags(ctx context.Context, tags []string) error
 
 	// Global operations
 	GetGlobalStats(ctx context.Context) (*GlobalStats, error)
-	GetCategories(ctx context.Context) (map[string]interface{}, error)
+	GetCategories(ctx context.Context) (map[string]Category, error)
 	GetTags(ctx context.Context) ([]string, error)
 	GetDirectoryContent(ctx context.Context, path string, mode string) ([]string, error)
 }
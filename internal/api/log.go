@@ -0,0 +1,62 @@
+package api
+
+import "time"
+
+// LogType is the severity of a main log message. The values are bit flags,
+// so a LogType can also select several severities.
+type LogType int
+
+const (
+	LogNormal   LogType = 1
+	LogInfo     LogType = 2
+	LogWarning  LogType = 4
+	LogCritical LogType = 8
+
+	LogAll = LogNormal | LogInfo | LogWarning | LogCritical
+)
+
+// LogTypes lists the severities from least to most severe
+var LogTypes = []LogType{LogNormal, LogInfo, LogWarning, LogCritical}
+
+// String returns the severity's name
+func (t LogType) String() string {
+	switch t {
+	case LogNormal:
+		return "normal"
+	case LogInfo:
+		return "info"
+	case LogWarning:
+		return "warning"
+	case LogCritical:
+		return "critical"
+	}
+	return "unknown"
+}
+
+// LogMessage is a message of the server's main log
+type LogMessage struct {
+	ID        int     `json:"id"`
+	Message   string  `json:"message"`
+	Timestamp int64   `json:"timestamp"` // Milliseconds since epoch
+	Type      LogType `json:"type"`
+}
+
+// Time returns when the message was logged
+func (m LogMessage) Time() time.Time {
+	return time.UnixMilli(m.Timestamp)
+}
+
+// PeerLogEntry is an entry of the server's peer log, recording peers the
+// IP filter or a ban blocked
+type PeerLogEntry struct {
+	ID        int    `json:"id"`
+	IP        string `json:"ip"`
+	Timestamp int64  `json:"timestamp"` // Milliseconds since epoch
+	Blocked   bool   `json:"blocked"`
+	Reason    string `json:"reason"`
+}
+
+// Time returns when the entry was logged
+func (e PeerLogEntry) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
@@ -2,7 +2,13 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -11,15 +17,31 @@ type MockClient struct {
 	Torrents          []Torrent
 	GlobalStats       *GlobalStats
 	TorrentProperties map[string]*TorrentProperties
-	Categories        map[string]interface{}
+	Categories        map[string]Category
 	Tags              []string
 	Trackers          map[string][]Tracker
 	Peers             map[string]map[string]Peer
+	BannedPeers       []string
+	Preferences       *Preferences
+	RSSFeeds          []RSSFeed
+	RSSRules          map[string]RSSRule
+	AddedURLs         []string            // URLs passed to AddTorrentURL
+	AddedFiles        []string            // Paths passed to AddTorrentFile
+	AddOptions        []AddTorrentOptions // Options of every add, in order
+	SearchPlugins     []SearchPlugin
+	SearchResults     []SearchResult // Found by every search whose words appear in the file name
+	searchJobs        map[int]*mockSearchJob
+	Log               []LogMessage
+	PeerLog           []PeerLogEntry
+	peerSnapshots     map[string]map[string]Peer // Peers last sent by SyncTorrentPeers, per torrent
+	peerRIDs          map[string]int             // RID last sent by SyncTorrentPeers, per torrent
 	Files             map[string][]TorrentFile
 	LoginError        error
 	GetError          error
 	LoggedIn          bool
-	currentRID        int // Track current RID for sync API
+	currentRID        int                 // Track current RID for sync API
+	syncedCategories  map[string]Category // Categories last sent by SyncMainData
+	syncedTags        []string            // Tags last sent by SyncMainData
 }
 
 // NewMockClient creates a new mock client with default test data
@@ -27,11 +49,13 @@ func NewMockClient() *MockClient {
 	return &MockClient{
 		Torrents:          []Torrent{},
 		TorrentProperties: make(map[string]*TorrentProperties),
-		Categories:        make(map[string]interface{}),
+		Categories:        make(map[string]Category),
 		Tags:              []string{},
 		Trackers:          make(map[string][]Tracker),
 		Peers:             make(map[string]map[string]Peer),
 		Files:             make(map[string][]TorrentFile),
+		RSSRules:          make(map[string]RSSRule),
+		searchJobs:        make(map[int]*mockSearchJob),
 		GlobalStats: &GlobalStats{
 			DlInfoSpeed:      1024 * 1024,
 			UpInfoSpeed:      512 * 1024,
@@ -39,6 +63,28 @@ func NewMockClient() *MockClient {
 			DHTNodes:         150,
 			FreeSpaceOnDisk:  1024 * 1024 * 1024 * 500, // 500GB
 		},
+		Preferences: &Preferences{
+			SavePath:                   "/downloads",
+			TempPath:                   "/downloads/temp",
+			ListenPort:                 6881,
+			UPnP:                       true,
+			MaxConnec:                  500,
+			MaxConnecPerTorrent:        100,
+			MaxUploads:                 20,
+			MaxUploadsPerTorrent:       4,
+			DHT:                        true,
+			PeX:                        true,
+			LSD:                        true,
+			MaxRatio:                   -1,
+			MaxSeedingTime:             -1,
+			QueueingEnabled:            true,
+			MaxActiveDownloads:         3,
+			MaxActiveUploads:           3,
+			MaxActiveTorrents:          5,
+			SlowTorrentDlRateThreshold: 2,
+			SlowTorrentUlRateThreshold: 2,
+			SlowTorrentInactiveTimer:   60,
+		},
 	}
 }
 
@@ -77,15 +123,17 @@ func (m *MockClient) SyncMainData(ctx context.Context, rid int) (*SyncMainDataRe
 
 	isFullUpdate := rid == 0
 
-	// Convert categories to Category type
-	categoriesMap := make(map[string]Category)
-	for name := range m.Categories {
-		categoriesMap[name] = Category{
-			Name:     name,
-			SavePath: "/downloads/" + name,
-		}
+	// Copy categories so callers can't mutate mock state through the response
+	categoriesMap := make(map[string]Category, len(m.Categories))
+	for name, cat := range m.Categories {
+		categoriesMap[name] = cat
 	}
 
+	// Categories and tags sent to the caller, to diff against next time
+	previousCategories, previousTags := m.syncedCategories, m.syncedTags
+	m.syncedCategories = categoriesMap
+	m.syncedTags = append([]string(nil), m.Tags...)
+
 	if isFullUpdate {
 		// Full update - return all torrents with complete data as PartialTorrent
 		torrentsMap := make(map[string]PartialTorrent)
@@ -108,18 +156,40 @@ func (m *MockClient) SyncMainData(ctx context.Context, rid int) (*SyncMainDataRe
 	// - Only changed torrents are included
 	// - In real API, changed fields might be partial (only changed fields sent)
 	// - For mock, we return empty map (no changes) to test the no-change case
-	// - Tests can modify mock state to simulate actual changes
-	return &SyncMainDataResponse{
+	// - Categories and tags created or removed since the last request are
+	//   reported, like the server does
+	response := &SyncMainDataResponse{
 		RID:               m.currentRID,
 		FullUpdate:        false,
 		Torrents:          make(map[string]PartialTorrent), // No changes by default
 		TorrentsRemoved:   []string{},
-		Categories:        make(map[string]Category), // No new categories
+		Categories:        make(map[string]Category),
 		CategoriesRemoved: []string{},
-		Tags:              []string{}, // No new tags
+		Tags:              []string{},
 		TagsRemoved:       []string{},
 		ServerState:       m.GlobalStats.toServerState(),
-	}, nil
+	}
+	for name, cat := range categoriesMap {
+		if old, exists := previousCategories[name]; !exists || old != cat {
+			response.Categories[name] = cat
+		}
+	}
+	for name := range previousCategories {
+		if _, exists := categoriesMap[name]; !exists {
+			response.CategoriesRemoved = append(response.CategoriesRemoved, name)
+		}
+	}
+	for _, tag := range m.Tags {
+		if !containsString(previousTags, tag) {
+			response.Tags = append(response.Tags, tag)
+		}
+	}
+	for _, tag := range previousTags {
+		if !containsString(m.Tags, tag) {
+			response.TagsRemoved = append(response.TagsRemoved, tag)
+		}
+	}
+	return response, nil
 }
 
 // torrentToPartial converts a full Torrent to a PartialTorrent with all fields set
@@ -150,10 +220,19 @@ func torrentToPartial(t Torrent) PartialTorrent {
 		RemainingSize:    &t.RemainingSize,
 		TimeActive:       &t.TimeActive,
 		AutoTMM:          &t.AutoTMM,
+		ForceStart:       &t.ForceStart,
+		SeqDownload:      &t.SeqDownload,
+		FirstLastPiece:   &t.FirstLastPiece,
 		TotalSize:        &t.TotalSize,
 		MaxRatio:         &t.MaxRatio,
 		MaxSeedingTime:   &t.MaxSeedingTime,
 		SeedingTimeLimit: &t.SeedingTimeLimit,
+		DlLimit:          &t.DlLimit,
+		UpLimit:          &t.UpLimit,
+
+		RatioLimit:               &t.RatioLimit,
+		InactiveSeedingTimeLimit: &t.InactiveSeedingTimeLimit,
+		MaxInactiveSeedingTime:   &t.MaxInactiveSeedingTime,
 	}
 }
 
@@ -182,7 +261,7 @@ func (m *MockClient) GetTorrentProperties(ctx context.Context, hash string) (*To
 	return props, nil
 }
 
-func (m *MockClient) GetCategories(ctx context.Context) (map[string]interface{}, error) {
+func (m *MockClient) GetCategories(ctx context.Context) (map[string]Category, error) {
 	if m.GetError != nil {
 		return nil, m.GetError
 	}
@@ -234,10 +313,80 @@ func (m *MockClient) GetTorrentPeers(ctx context.Context, hash string) (map[stri
 	if !m.LoggedIn {
 		return nil, fmt.Errorf("authentication required")
 	}
+	return m.torrentPeers(hash), nil
+}
+
+// SyncTorrentPeers simulates the peer sync API: a full update for rid=0 or
+// an unknown rid, otherwise only the peers that changed since that response
+func (m *MockClient) SyncTorrentPeers(ctx context.Context, hash string, rid int) (*SyncTorrentPeersResponse, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	if m.peerSnapshots == nil {
+		m.peerSnapshots = make(map[string]map[string]Peer)
+		m.peerRIDs = make(map[string]int)
+	}
+
+	current := m.torrentPeers(hash)
+	previous := m.peerSnapshots[hash]
+	fullUpdate := rid == 0 || rid != m.peerRIDs[hash]
+
+	response := &SyncTorrentPeersResponse{
+		FullUpdate:   fullUpdate,
+		Peers:        make(map[string]PartialPeer),
+		PeersRemoved: []string{},
+	}
+	for id, peer := range current {
+		if old, exists := previous[id]; fullUpdate || !exists || old != peer {
+			response.Peers[id] = peerToPartial(peer)
+		}
+	}
+	if !fullUpdate {
+		for id := range previous {
+			if _, exists := current[id]; !exists {
+				response.PeersRemoved = append(response.PeersRemoved, id)
+			}
+		}
+	}
+
+	snapshot := make(map[string]Peer, len(current))
+	for id, peer := range current {
+		snapshot[id] = peer
+	}
+	m.peerSnapshots[hash] = snapshot
+	m.peerRIDs[hash] = rid + 1
+	response.RID = rid + 1
+	return response, nil
+}
+
+// peerToPartial converts a full Peer to a PartialPeer with all fields set
+func peerToPartial(p Peer) PartialPeer {
+	return PartialPeer{
+		IP:          &p.IP,
+		Port:        &p.Port,
+		Country:     &p.Country,
+		Connection:  &p.Connection,
+		Flags:       &p.Flags,
+		Client:      &p.Client,
+		Progress:    &p.Progress,
+		DlSpeed:     &p.DlSpeed,
+		UpSpeed:     &p.UpSpeed,
+		Downloaded:  &p.Downloaded,
+		Uploaded:    &p.Uploaded,
+		Relevance:   &p.Relevance,
+		FilesString: &p.FilesString,
+	}
+}
+
+// torrentPeers returns the configured peers of a torrent, or default mock
+// data when none are set
+func (m *MockClient) torrentPeers(hash string) map[string]Peer {
 	if peers, exists := m.Peers[hash]; exists {
-		return peers, nil
+		return peers
 	}
-	// Return mock data if no specific data is set
 	return map[string]Peer{
 		"192.168.1.100:51413": {
 			IP:         "192.168.1.100",
@@ -253,7 +402,7 @@ func (m *MockClient) GetTorrentPeers(ctx context.Context, hash string) (map[stri
 			Uploaded:   1024 * 1024 * 200,
 			Relevance:  1.0,
 		},
-	}, nil
+	}
 }
 
 func (m *MockClient) GetTorrentFiles(ctx context.Context, hash string) ([]TorrentFile, error) {
@@ -378,6 +527,86 @@ func (m *MockClient) ResumeTorrents(ctx context.Context, hashes []string) error
 	return nil
 }
 
+// RecheckTorrents simulates rechecking torrents
+func (m *MockClient) RecheckTorrents(ctx context.Context, hashes []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			if m.Torrents[i].Progress >= 1 {
+				m.Torrents[i].State = "checkingUP"
+			} else {
+				m.Torrents[i].State = "checkingDL"
+			}
+		}
+	}
+	return nil
+}
+
+// ReannounceTorrents simulates reannouncing torrents to their trackers
+func (m *MockClient) ReannounceTorrents(ctx context.Context, hashes []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	// Mock implementation - in real usage this would contact the trackers
+	return nil
+}
+
+// SetForceStart simulates toggling force start on torrents
+func (m *MockClient) SetForceStart(ctx context.Context, hashes []string, enabled bool) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].ForceStart = enabled
+		}
+	}
+	return nil
+}
+
+// ToggleSequentialDownload simulates flipping sequential download on torrents
+func (m *MockClient) ToggleSequentialDownload(ctx context.Context, hashes []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].SeqDownload = !m.Torrents[i].SeqDownload
+		}
+	}
+	return nil
+}
+
+// ToggleFirstLastPiecePrio simulates flipping first/last piece priority on torrents
+func (m *MockClient) ToggleFirstLastPiecePrio(ctx context.Context, hashes []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].FirstLastPiece = !m.Torrents[i].FirstLastPiece
+		}
+	}
+	return nil
+}
+
 // DeleteTorrents simulates deleting torrents
 func (m *MockClient) DeleteTorrents(ctx context.Context, hashes []string, deleteFiles bool) error {
 	if m.GetError != nil {
@@ -391,26 +620,54 @@ func (m *MockClient) DeleteTorrents(ctx context.Context, hashes []string, delete
 }
 
 // AddTorrentFile simulates adding a torrent from a file
-func (m *MockClient) AddTorrentFile(ctx context.Context, filePath string) error {
+func (m *MockClient) AddTorrentFile(ctx context.Context, filePath string, opts AddTorrentOptions) error {
 	if m.GetError != nil {
 		return m.GetError
 	}
 	if !m.LoggedIn {
 		return fmt.Errorf("authentication required")
 	}
-	// Mock implementation - in real usage this would add the torrent file
+	if err := m.addTorrent(opts); err != nil {
+		return err
+	}
+	m.AddedFiles = append(m.AddedFiles, filePath)
 	return nil
 }
 
 // AddTorrentURL simulates adding a torrent from a URL
-func (m *MockClient) AddTorrentURL(ctx context.Context, url string) error {
+func (m *MockClient) AddTorrentURL(ctx context.Context, url string, opts AddTorrentOptions) error {
 	if m.GetError != nil {
 		return m.GetError
 	}
 	if !m.LoggedIn {
 		return fmt.Errorf("authentication required")
 	}
-	// Mock implementation - in real usage this would add the torrent from URL
+	if err := m.addTorrent(opts); err != nil {
+		return err
+	}
+	m.AddedURLs = append(m.AddedURLs, url)
+	return nil
+}
+
+// addTorrent validates and records add options, creating missing
+// categories and tags like the server does
+func (m *MockClient) addTorrent(opts AddTorrentOptions) error {
+	switch opts.ContentLayout {
+	case "", ContentLayoutOriginal, ContentLayoutSubfolder, ContentLayoutNoSubfolder:
+	default:
+		return NewValidationError(fmt.Sprintf("invalid content layout %q", opts.ContentLayout), nil)
+	}
+	if opts.Category != "" {
+		if _, ok := m.Categories[opts.Category]; !ok {
+			m.Categories[opts.Category] = Category{Name: opts.Category}
+		}
+	}
+	for _, tag := range opts.Tags {
+		if !containsString(m.Tags, tag) {
+			m.Tags = append(m.Tags, tag)
+		}
+	}
+	m.AddOptions = append(m.AddOptions, opts)
 	return nil
 }
 
@@ -447,16 +704,957 @@ func (m *MockClient) GetDirectoryContent(ctx context.Context, path string, mode
 	}
 }
 
+// CreateCategory simulates creating a category
+func (m *MockClient) CreateCategory(ctx context.Context, name, savePath string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	if _, exists := m.Categories[name]; exists {
+		return NewValidationError("category already exists", nil)
+	}
+	m.Categories[name] = Category{Name: name, SavePath: savePath}
+	return nil
+}
+
+// EditCategory simulates changing a category's save path
+func (m *MockClient) EditCategory(ctx context.Context, name, savePath string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	cat, exists := m.Categories[name]
+	if !exists {
+		return NewValidationError("category does not exist", nil)
+	}
+	cat.SavePath = savePath
+	m.Categories[name] = cat
+	return nil
+}
+
+// RemoveCategories simulates removing categories and clearing them from torrents
+func (m *MockClient) RemoveCategories(ctx context.Context, names []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for _, name := range names {
+		delete(m.Categories, name)
+		for i := range m.Torrents {
+			if m.Torrents[i].Category == name {
+				m.Torrents[i].Category = ""
+			}
+		}
+	}
+	return nil
+}
+
+// SetCategory simulates assigning a category to torrents
+func (m *MockClient) SetCategory(ctx context.Context, hashes []string, category string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	if _, exists := m.Categories[category]; category != "" && !exists {
+		return NewValidationError("category does not exist", nil)
+	}
+	for i := range m.Torrents {
+		for _, hash := range hashes {
+			if m.Torrents[i].Hash == hash {
+				m.Torrents[i].Category = category
+			}
+		}
+	}
+	return nil
+}
+
+// queueOrder returns the indexes of queued torrents (Priority > 0) in queue order
+func (m *MockClient) queueOrder() []int {
+	var queue []int
+	for i, torrent := range m.Torrents {
+		if torrent.Priority > 0 {
+			queue = append(queue, i)
+		}
+	}
+	sort.Slice(queue, func(a, b int) bool {
+		return m.Torrents[queue[a]].Priority < m.Torrents[queue[b]].Priority
+	})
+	return queue
+}
+
+// reorderQueue applies a reordering of the queue and renumbers the positions
+func (m *MockClient) reorderQueue(hashes []string, reorder func(queue []int, moved func(int) bool) []int) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	moved := func(i int) bool { return containsString(hashes, m.Torrents[i].Hash) }
+	for position, i := range reorder(m.queueOrder(), moved) {
+		m.Torrents[i].Priority = position + 1
+	}
+	return nil
+}
+
+// AddTrackers simulates adding trackers to a torrent
+func (m *MockClient) AddTrackers(ctx context.Context, hash string, urls []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for _, url := range urls {
+		exists := false
+		for _, tracker := range m.Trackers[hash] {
+			exists = exists || tracker.URL == url
+		}
+		if !exists {
+			m.Trackers[hash] = append(m.Trackers[hash], Tracker{URL: url, Status: 1})
+		}
+	}
+	return nil
+}
+
+// EditTracker simulates replacing a tracker URL, failing like qBittorrent
+// when the original is missing or the new URL already exists
+func (m *MockClient) EditTracker(ctx context.Context, hash, origURL, newURL string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	found := -1
+	for i, tracker := range m.Trackers[hash] {
+		if tracker.URL == newURL {
+			return NewValidationError("new tracker URL already exists", nil)
+		}
+		if tracker.URL == origURL {
+			found = i
+		}
+	}
+	if found < 0 {
+		return NewValidationError("tracker URL not found", nil)
+	}
+	m.Trackers[hash][found].URL = newURL
+	return nil
+}
+
+// RemoveTrackers simulates removing trackers from a torrent
+func (m *MockClient) RemoveTrackers(ctx context.Context, hash string, urls []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	var kept []Tracker
+	for _, tracker := range m.Trackers[hash] {
+		if !containsString(urls, tracker.URL) {
+			kept = append(kept, tracker)
+		}
+	}
+	m.Trackers[hash] = kept
+	return nil
+}
+
+// AddPeers simulates connecting torrents to the given peers
+func (m *MockClient) AddPeers(ctx context.Context, hashes []string, peers []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for _, hash := range hashes {
+		if m.Peers[hash] == nil {
+			m.Peers[hash] = make(map[string]Peer)
+		}
+		for _, addr := range peers {
+			host, portStr, err := net.SplitHostPort(addr)
+			if err != nil {
+				return NewValidationError(fmt.Sprintf("invalid peer: %s", addr), err)
+			}
+			port, _ := strconv.Atoi(portStr)
+			m.Peers[hash][addr] = Peer{IP: host, Port: port, Connection: "BT"}
+		}
+	}
+	return nil
+}
+
+// BanPeers simulates banning peers, disconnecting them from every torrent
+func (m *MockClient) BanPeers(ctx context.Context, peers []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for _, addr := range peers {
+		for _, torrentPeers := range m.Peers {
+			delete(torrentPeers, addr)
+		}
+		if !containsString(m.BannedPeers, addr) {
+			m.BannedPeers = append(m.BannedPeers, addr)
+		}
+	}
+	return nil
+}
+
+// IncreasePriority simulates moving torrents one position up in the queue
+func (m *MockClient) IncreasePriority(ctx context.Context, hashes []string) error {
+	return m.reorderQueue(hashes, func(queue []int, moved func(int) bool) []int {
+		for pos := 1; pos < len(queue); pos++ {
+			if moved(queue[pos]) && !moved(queue[pos-1]) {
+				queue[pos-1], queue[pos] = queue[pos], queue[pos-1]
+			}
+		}
+		return queue
+	})
+}
+
+// DecreasePriority simulates moving torrents one position down in the queue
+func (m *MockClient) DecreasePriority(ctx context.Context, hashes []string) error {
+	return m.reorderQueue(hashes, func(queue []int, moved func(int) bool) []int {
+		for pos := len(queue) - 2; pos >= 0; pos-- {
+			if moved(queue[pos]) && !moved(queue[pos+1]) {
+				queue[pos], queue[pos+1] = queue[pos+1], queue[pos]
+			}
+		}
+		return queue
+	})
+}
+
+// TopPriority simulates moving torrents to the top of the queue
+func (m *MockClient) TopPriority(ctx context.Context, hashes []string) error {
+	return m.reorderQueue(hashes, func(queue []int, moved func(int) bool) []int {
+		sort.SliceStable(queue, func(a, b int) bool { return moved(queue[a]) && !moved(queue[b]) })
+		return queue
+	})
+}
+
+// BottomPriority simulates moving torrents to the bottom of the queue
+func (m *MockClient) BottomPriority(ctx context.Context, hashes []string) error {
+	return m.reorderQueue(hashes, func(queue []int, moved func(int) bool) []int {
+		sort.SliceStable(queue, func(a, b int) bool { return !moved(queue[a]) && moved(queue[b]) })
+		return queue
+	})
+}
+
+// SetTorrentDownloadLimit simulates setting per-torrent download limits
+func (m *MockClient) SetTorrentDownloadLimit(ctx context.Context, hashes []string, limit int64) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].DlLimit = limit
+		}
+	}
+	return nil
+}
+
+// SetTorrentUploadLimit simulates setting per-torrent upload limits
+func (m *MockClient) SetTorrentUploadLimit(ctx context.Context, hashes []string, limit int64) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].UpLimit = limit
+		}
+	}
+	return nil
+}
+
+// SetGlobalDownloadLimit simulates setting the global download limit
+func (m *MockClient) SetGlobalDownloadLimit(ctx context.Context, limit int64) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.GlobalStats.DlRateLimit = limit
+	return nil
+}
+
+// SetGlobalUploadLimit simulates setting the global upload limit
+func (m *MockClient) SetGlobalUploadLimit(ctx context.Context, limit int64) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.GlobalStats.UpRateLimit = limit
+	return nil
+}
+
+// ToggleAltSpeedLimits simulates switching alternative speed limits on or off
+func (m *MockClient) ToggleAltSpeedLimits(ctx context.Context) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.GlobalStats.UseAltSpeedLimits = !m.GlobalStats.UseAltSpeedLimits
+	return nil
+}
+
+// GetAltSpeedLimitsMode returns whether alternative speed limits are active
+func (m *MockClient) GetAltSpeedLimitsMode(ctx context.Context) (bool, error) {
+	if m.GetError != nil {
+		return false, m.GetError
+	}
+	if !m.LoggedIn {
+		return false, fmt.Errorf("authentication required")
+	}
+	return m.GlobalStats.UseAltSpeedLimits, nil
+}
+
+// GetPreferences returns a copy of the mock preferences
+func (m *MockClient) GetPreferences(ctx context.Context) (*Preferences, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	prefs := *m.Preferences
+	return &prefs, nil
+}
+
+// SetPreferences simulates updating preferences, applying only the given keys
+func (m *MockClient) SetPreferences(ctx context.Context, changes map[string]any) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	// Round-trip through JSON like the server, which ignores unknown keys
+	current, _ := json.Marshal(m.Preferences)
+	values := make(map[string]any)
+	if err := json.Unmarshal(current, &values); err != nil {
+		return err
+	}
+	for key, value := range changes {
+		values[key] = value
+	}
+	merged, err := json.Marshal(values)
+	if err != nil {
+		return NewValidationError("invalid preferences", err)
+	}
+	var prefs Preferences
+	if err := json.Unmarshal(merged, &prefs); err != nil {
+		return NewValidationError("invalid preferences", err)
+	}
+	m.Preferences = &prefs
+	return nil
+}
+
+// GetRSSItems returns a copy of the mock feeds, without articles unless withData
+func (m *MockClient) GetRSSItems(ctx context.Context, withData bool) ([]RSSFeed, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	feeds := make([]RSSFeed, len(m.RSSFeeds))
+	for i, feed := range m.RSSFeeds {
+		feed.Articles = nil
+		if withData {
+			feed.Articles = append([]RSSArticle{}, m.RSSFeeds[i].Articles...)
+		}
+		feeds[i] = feed
+	}
+	return feeds, nil
+}
+
+// AddRSSFeed simulates subscribing to a feed
+func (m *MockClient) AddRSSFeed(ctx context.Context, feedURL, path string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	if path == "" {
+		path = feedURL
+	}
+	for _, feed := range m.RSSFeeds {
+		if feed.URL == feedURL {
+			return NewValidationError(fmt.Sprintf("feed %s already exists", feedURL), nil)
+		}
+		if feed.Path == path {
+			return NewValidationError(fmt.Sprintf("RSS item %s already exists", path), nil)
+		}
+	}
+	m.RSSFeeds = append(m.RSSFeeds, RSSFeed{
+		Path: path,
+		UID:  fmt.Sprintf("feed-%d", len(m.RSSFeeds)+1),
+		URL:  feedURL,
+	})
+	sort.Slice(m.RSSFeeds, func(i, j int) bool {
+		return strings.ToLower(m.RSSFeeds[i].Path) < strings.ToLower(m.RSSFeeds[j].Path)
+	})
+	return nil
+}
+
+// RemoveRSSItem simulates removing a feed, or a folder with its feeds
+func (m *MockClient) RemoveRSSItem(ctx context.Context, path string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	var kept []RSSFeed
+	for _, feed := range m.RSSFeeds {
+		if !rssPathWithin(feed.Path, path) {
+			kept = append(kept, feed)
+		}
+	}
+	if len(kept) == len(m.RSSFeeds) {
+		return NewValidationError(fmt.Sprintf("RSS item %s not found", path), nil)
+	}
+	m.RSSFeeds = kept
+	return nil
+}
+
+// RefreshRSSItem simulates refreshing feeds, which has no visible effect
+func (m *MockClient) RefreshRSSItem(ctx context.Context, path string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for _, feed := range m.RSSFeeds {
+		if rssPathWithin(feed.Path, path) {
+			return nil
+		}
+	}
+	return NewValidationError(fmt.Sprintf("RSS item %s not found", path), nil)
+}
+
+// MarkRSSAsRead simulates marking an article, or all articles under a path, as read
+func (m *MockClient) MarkRSSAsRead(ctx context.Context, path, articleID string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.RSSFeeds {
+		if !rssPathWithin(m.RSSFeeds[i].Path, path) {
+			continue
+		}
+		for j := range m.RSSFeeds[i].Articles {
+			if articleID == "" || m.RSSFeeds[i].Articles[j].ID == articleID {
+				m.RSSFeeds[i].Articles[j].IsRead = true
+			}
+		}
+	}
+	return nil
+}
+
+// GetRSSRules returns a copy of the mock auto-download rules
+func (m *MockClient) GetRSSRules(ctx context.Context) (map[string]RSSRule, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	rules := make(map[string]RSSRule, len(m.RSSRules))
+	for name, rule := range m.RSSRules {
+		rules[name] = rule
+	}
+	return rules, nil
+}
+
+// SetRSSRule simulates creating or replacing an auto-download rule
+func (m *MockClient) SetRSSRule(ctx context.Context, name string, rule RSSRule) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	if name == "" {
+		return NewValidationError("rule name is required", nil)
+	}
+	m.RSSRules[name] = rule
+	return nil
+}
+
+// GetRSSMatchingArticles returns the titles of articles a rule matches in the
+// feeds it applies to, keyed by feed name
+func (m *MockClient) GetRSSMatchingArticles(ctx context.Context, ruleName string) (map[string][]string, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	rule, ok := m.RSSRules[ruleName]
+	if !ok {
+		return nil, NewValidationError(fmt.Sprintf("rule %s not found", ruleName), nil)
+	}
+	matches := make(map[string][]string)
+	for _, feed := range m.RSSFeeds {
+		if !slices.Contains(rule.AffectedFeeds, feed.URL) {
+			continue
+		}
+		name := feed.Path[strings.LastIndex(feed.Path, RSSPathSeparator)+1:]
+		for _, article := range feed.Articles {
+			if ok, _ := rule.Matches(article.Title); ok {
+				matches[name] = append(matches[name], article.Title)
+			}
+		}
+	}
+	return matches, nil
+}
+
+// rssPathWithin reports whether an item path is the given path or inside it;
+// the empty path is the root folder
+func rssPathWithin(itemPath, path string) bool {
+	return path == "" || itemPath == path || strings.HasPrefix(itemPath, path+RSSPathSeparator)
+}
+
+// mockSearchBatch is how many more results a mock search finds on each poll
+const mockSearchBatch = 2
+
+// mockSearchJob is a search started on the mock client
+type mockSearchJob struct {
+	results []SearchResult
+	found   int // Results revealed so far
+	stopped bool
+}
+
+// status returns the job's state the way the search API reports it
+func (j *mockSearchJob) status() string {
+	if j.stopped || j.found == len(j.results) {
+		return SearchStatusStopped
+	}
+	return SearchStatusRunning
+}
+
+// StartSearch simulates starting a search; results are found a few at a time
+// as GetSearchResults is polled
+func (m *MockClient) StartSearch(ctx context.Context, pattern string, plugins []string, category string) (int, error) {
+	if m.GetError != nil {
+		return 0, m.GetError
+	}
+	if !m.LoggedIn {
+		return 0, fmt.Errorf("authentication required")
+	}
+	words := strings.Fields(strings.ToLower(pattern))
+	if len(words) == 0 {
+		return 0, NewValidationError("search pattern is required", nil)
+	}
+
+	job := &mockSearchJob{}
+	for _, result := range m.SearchResults {
+		if len(plugins) > 0 && !slices.Contains(plugins, result.EngineName) {
+			continue
+		}
+		name := strings.ToLower(result.FileName)
+		if !slices.ContainsFunc(words, func(word string) bool { return !strings.Contains(name, word) }) {
+			job.results = append(job.results, result)
+		}
+	}
+	id := len(m.searchJobs) + 1
+	for m.searchJobs[id] != nil {
+		id++
+	}
+	m.searchJobs[id] = job
+	return id, nil
+}
+
+// GetSearchStatus returns the state of a mock search job, or of all of them
+func (m *MockClient) GetSearchStatus(ctx context.Context, id int) ([]SearchStatus, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	var statuses []SearchStatus
+	for jobID, job := range m.searchJobs {
+		if id == 0 || id == jobID {
+			statuses = append(statuses, SearchStatus{ID: jobID, Status: job.status(), Total: job.found})
+		}
+	}
+	if id != 0 && len(statuses) == 0 {
+		return nil, NewValidationError(fmt.Sprintf("search job %d not found", id), nil)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].ID < statuses[j].ID })
+	return statuses, nil
+}
+
+// GetSearchResults finds a few more results for a running mock search and
+// returns the page starting at offset
+func (m *MockClient) GetSearchResults(ctx context.Context, id, limit, offset int) (*SearchResults, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	job, ok := m.searchJobs[id]
+	if !ok {
+		return nil, NewValidationError(fmt.Sprintf("search job %d not found", id), nil)
+	}
+	if !job.stopped {
+		job.found = min(job.found+mockSearchBatch, len(job.results))
+	}
+
+	start := min(max(offset, 0), job.found)
+	end := job.found
+	if limit > 0 {
+		end = min(start+limit, end)
+	}
+	return &SearchResults{
+		Results: append([]SearchResult{}, job.results[start:end]...),
+		Status:  job.status(),
+		Total:   job.found,
+	}, nil
+}
+
+// StopSearch simulates stopping a search, keeping the results found so far
+func (m *MockClient) StopSearch(ctx context.Context, id int) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	job, ok := m.searchJobs[id]
+	if !ok {
+		return NewValidationError(fmt.Sprintf("search job %d not found", id), nil)
+	}
+	job.stopped = true
+	return nil
+}
+
+// DeleteSearch simulates discarding a search job
+func (m *MockClient) DeleteSearch(ctx context.Context, id int) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	if _, ok := m.searchJobs[id]; !ok {
+		return NewValidationError(fmt.Sprintf("search job %d not found", id), nil)
+	}
+	delete(m.searchJobs, id)
+	return nil
+}
+
+// GetSearchPlugins returns a copy of the mock search plugins
+func (m *MockClient) GetSearchPlugins(ctx context.Context) ([]SearchPlugin, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	return append([]SearchPlugin{}, m.SearchPlugins...), nil
+}
+
+// EnableSearchPlugins simulates enabling or disabling search plugins
+func (m *MockClient) EnableSearchPlugins(ctx context.Context, names []string, enable bool) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for _, name := range names {
+		i := slices.IndexFunc(m.SearchPlugins, func(p SearchPlugin) bool { return p.Name == name })
+		if i < 0 {
+			return NewValidationError(fmt.Sprintf("search plugin %s not found", name), nil)
+		}
+		m.SearchPlugins[i].Enabled = enable
+	}
+	return nil
+}
+
+// GetLog simulates fetching main log messages after lastKnownID
+func (m *MockClient) GetLog(ctx context.Context, types LogType, lastKnownID int) ([]LogMessage, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	messages := []LogMessage{}
+	for _, message := range m.Log {
+		if message.ID > lastKnownID && message.Type&types != 0 {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil
+}
+
+// GetPeerLog simulates fetching peer log entries after lastKnownID
+func (m *MockClient) GetPeerLog(ctx context.Context, lastKnownID int) ([]PeerLogEntry, error) {
+	if m.GetError != nil {
+		return nil, m.GetError
+	}
+	if !m.LoggedIn {
+		return nil, fmt.Errorf("authentication required")
+	}
+	entries := []PeerLogEntry{}
+	for _, entry := range m.PeerLog {
+		if entry.ID > lastKnownID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// SetShareLimits simulates setting share limits on torrents
+func (m *MockClient) SetShareLimits(ctx context.Context, hashes []string, limits ShareLimits) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].RatioLimit = limits.RatioLimit
+			m.Torrents[i].SeedingTimeLimit = limits.SeedingTimeLimit
+			m.Torrents[i].InactiveSeedingTimeLimit = limits.InactiveSeedingTimeLimit
+		}
+	}
+	return nil
+}
+
+// SetFilePriority simulates changing file priorities within a torrent
+func (m *MockClient) SetFilePriority(ctx context.Context, hash string, fileIDs []int, priority int) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	files := m.Files[hash]
+	for i := range files {
+		for _, id := range fileIDs {
+			if files[i].Index == id {
+				files[i].Priority = priority
+			}
+		}
+	}
+	return nil
+}
+
+// RenameTorrent simulates renaming a torrent
+func (m *MockClient) RenameTorrent(ctx context.Context, hash, name string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	for i := range m.Torrents {
+		if m.Torrents[i].Hash == hash {
+			m.Torrents[i].Name = name
+			return nil
+		}
+	}
+	return NewValidationError("torrent not found", nil)
+}
+
+// RenameFile simulates renaming a file within a torrent
+func (m *MockClient) RenameFile(ctx context.Context, hash, oldPath, newPath string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	files := m.Files[hash]
+	for i := range files {
+		if files[i].Name == oldPath {
+			files[i].Name = newPath
+			return nil
+		}
+	}
+	return NewValidationError("file not found", nil)
+}
+
+// RenameFolder simulates renaming a folder within a torrent
+func (m *MockClient) RenameFolder(ctx context.Context, hash, oldPath, newPath string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	found := false
+	files := m.Files[hash]
+	for i := range files {
+		if strings.HasPrefix(files[i].Name, oldPath+"/") {
+			files[i].Name = newPath + strings.TrimPrefix(files[i].Name, oldPath)
+			found = true
+		}
+	}
+	if !found {
+		return NewValidationError("folder not found", nil)
+	}
+	return nil
+}
+
+// AddTags simulates adding tags to torrents, creating unknown tags globally
+func (m *MockClient) AddTags(ctx context.Context, hashes []string, tags []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.createMissingTags(tags)
+	m.updateTorrentTags(hashes, func(current []string) []string {
+		for _, tag := range tags {
+			if !containsString(current, tag) {
+				current = append(current, tag)
+			}
+		}
+		return current
+	})
+	return nil
+}
+
+// RemoveTags simulates removing tags from torrents
+func (m *MockClient) RemoveTags(ctx context.Context, hashes []string, tags []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.updateTorrentTags(hashes, func(current []string) []string {
+		return removeStrings(current, tags)
+	})
+	return nil
+}
+
+// CreateTags simulates adding tags to the global tag list
+func (m *MockClient) CreateTags(ctx context.Context, tags []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.createMissingTags(tags)
+	return nil
+}
+
+// DeleteTags simulates deleting tags globally and from all torrents
+func (m *MockClient) DeleteTags(ctx context.Context, tags []string) error {
+	if m.GetError != nil {
+		return m.GetError
+	}
+	if !m.LoggedIn {
+		return fmt.Errorf("authentication required")
+	}
+	m.Tags = removeStrings(m.Tags, tags)
+	for i := range m.Torrents {
+		m.Torrents[i].Tags = joinTags(removeStrings(splitTags(m.Torrents[i].Tags), tags))
+	}
+	return nil
+}
+
+func (m *MockClient) createMissingTags(tags []string) {
+	for _, tag := range tags {
+		if !containsString(m.Tags, tag) {
+			m.Tags = append(m.Tags, tag)
+		}
+	}
+}
+
+func (m *MockClient) updateTorrentTags(hashes []string, update func([]string) []string) {
+	for i := range m.Torrents {
+		if containsString(hashes, m.Torrents[i].Hash) {
+			m.Torrents[i].Tags = joinTags(update(splitTags(m.Torrents[i].Tags)))
+		}
+	}
+}
+
+// splitTags and joinTags convert between a tag slice and the ", " separated
+// string qBittorrent reports on torrents
+func splitTags(tags string) []string {
+	var result []string
+	for _, tag := range strings.Split(tags, ",") {
+		if trimmed := strings.TrimSpace(tag); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
+func joinTags(tags []string) string {
+	return strings.Join(tags, ", ")
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func removeStrings(list []string, remove []string) []string {
+	var result []string
+	for _, item := range list {
+		if !containsString(remove, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // toServerState converts GlobalStats to ServerState for sync API responses
 func (g *GlobalStats) toServerState() ServerState {
 	return ServerState{
-		ConnectionStatus: &g.ConnectionStatus,
-		DHTNodes:         &g.DHTNodes,
-		DlInfoSpeed:      &g.DlInfoSpeed,
-		UpInfoSpeed:      &g.UpInfoSpeed,
-		DlInfoData:       &g.DlInfoData,
-		UpInfoData:       &g.UpInfoData,
-		FreeSpaceOnDisk:  &g.FreeSpaceOnDisk,
+		ConnectionStatus:  &g.ConnectionStatus,
+		DHTNodes:          &g.DHTNodes,
+		DlInfoSpeed:       &g.DlInfoSpeed,
+		UpInfoSpeed:       &g.UpInfoSpeed,
+		DlInfoData:        &g.DlInfoData,
+		UpInfoData:        &g.UpInfoData,
+		FreeSpaceOnDisk:   &g.FreeSpaceOnDisk,
+		DlRateLimit:       &g.DlRateLimit,
+		UpRateLimit:       &g.UpRateLimit,
+		UseAltSpeedLimits: &g.UseAltSpeedLimits,
 	}
 }
 
@@ -464,9 +1662,9 @@ func (g *GlobalStats) toServerState() ServerState {
 func SetupMockClientWithData() *MockClient {
 	client := NewMockClient()
 	client.Torrents = GenerateMockTorrents(5)
-	client.Categories = map[string]interface{}{
-		"movies": map[string]interface{}{"name": "Movies", "savePath": "/downloads/movies"},
-		"tv":     map[string]interface{}{"name": "TV Shows", "savePath": "/downloads/tv"},
+	client.Categories = map[string]Category{
+		"movies": {Name: "movies", SavePath: "/downloads/movies"},
+		"tv":     {Name: "tv", SavePath: "/downloads/tv"},
 	}
 	client.Tags = []string{"hd", "4k", "favorite"}
 	return client
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// Encryption modes for Preferences.Encryption
+const (
+	EncryptionPreferred = 0
+	EncryptionForced    = 1
+	EncryptionDisabled  = 2
+)
+
+// Preferences holds the application preferences from /api/v2/app/preferences
+// that the preferences editor exposes. Keys the server returns but this
+// struct doesn't model are ignored and never sent back, so they stay untouched.
+type Preferences struct {
+	// Downloads
+	SavePath           string `json:"save_path"`
+	TempPathEnabled    bool   `json:"temp_path_enabled"`
+	TempPath           string `json:"temp_path"`
+	AutoTMMEnabled     bool   `json:"auto_tmm_enabled"`
+	PreallocateAll     bool   `json:"preallocate_all"`
+	IncompleteFilesExt bool   `json:"incomplete_files_ext"`
+
+	// Connection, -1 means unlimited for the connection and upload slot limits
+	ListenPort           int  `json:"listen_port"`
+	UPnP                 bool `json:"upnp"`
+	MaxConnec            int  `json:"max_connec"`
+	MaxConnecPerTorrent  int  `json:"max_connec_per_torrent"`
+	MaxUploads           int  `json:"max_uploads"`
+	MaxUploadsPerTorrent int  `json:"max_uploads_per_torrent"`
+
+	// Speed, in bytes/s with 0 meaning unlimited
+	DlLimit          int64 `json:"dl_limit"`
+	UpLimit          int64 `json:"up_limit"`
+	AltDlLimit       int64 `json:"alt_dl_limit"`
+	AltUpLimit       int64 `json:"alt_up_limit"`
+	LimitUTPRate     bool  `json:"limit_utp_rate"`
+	LimitTCPOverhead bool  `json:"limit_tcp_overhead"`
+	LimitLANPeers    bool  `json:"limit_lan_peers"`
+	SchedulerEnabled bool  `json:"scheduler_enabled"`
+
+	// BitTorrent
+	DHT                   bool    `json:"dht"`
+	PeX                   bool    `json:"pex"`
+	LSD                   bool    `json:"lsd"`
+	Encryption            int     `json:"encryption"`
+	AnonymousMode         bool    `json:"anonymous_mode"`
+	MaxRatioEnabled       bool    `json:"max_ratio_enabled"`
+	MaxRatio              float64 `json:"max_ratio"`
+	MaxSeedingTimeEnabled bool    `json:"max_seeding_time_enabled"`
+	MaxSeedingTime        int64   `json:"max_seeding_time"` // Minutes
+
+	// Queueing, -1 means unlimited for the active torrent limits
+	QueueingEnabled            bool `json:"queueing_enabled"`
+	MaxActiveDownloads         int  `json:"max_active_downloads"`
+	MaxActiveUploads           int  `json:"max_active_uploads"`
+	MaxActiveTorrents          int  `json:"max_active_torrents"`
+	DontCountSlowTorrents      bool `json:"dont_count_slow_torrents"`
+	SlowTorrentDlRateThreshold int  `json:"slow_torrent_dl_rate_threshold"` // KiB/s
+	SlowTorrentUlRateThreshold int  `json:"slow_torrent_ul_rate_threshold"` // KiB/s
+	SlowTorrentInactiveTimer   int  `json:"slow_torrent_inactive_timer"`    // Seconds
+}
+
+// Changes returns the preferences that differ from the original, keyed by
+// their API name, ready to send to SetPreferences
+func (p *Preferences) Changes(original *Preferences) map[string]any {
+	current, before := p.toMap(), original.toMap()
+	changes := make(map[string]any)
+	for key, value := range current {
+		if !reflect.DeepEqual(value, before[key]) {
+			changes[key] = value
+		}
+	}
+	return changes
+}
+
+// toMap converts the preferences to their JSON form, keeping numbers exact
+func (p *Preferences) toMap() map[string]any {
+	data, _ := json.Marshal(p)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	values := make(map[string]any)
+	_ = decoder.Decode(&values)
+	return values
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// RSSPathSeparator separates folder and feed names in RSS item paths
+const RSSPathSeparator = `\`
+
+// RSSArticle is an article of an RSS feed
+type RSSArticle struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Date        string `json:"date"`
+	Author      string `json:"author"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	Link        string `json:"link"`
+	TorrentURL  string `json:"torrentURL"`
+	IsRead      bool   `json:"isRead"`
+}
+
+// DownloadURL returns the URL to add the article's torrent from
+func (a RSSArticle) DownloadURL() string {
+	if a.TorrentURL != "" {
+		return a.TorrentURL
+	}
+	return a.Link
+}
+
+// RSSFeed is an RSS feed. Path is its location in the feed tree, with
+// folders separated by RSSPathSeparator.
+type RSSFeed struct {
+	Path          string       `json:"-"`
+	UID           string       `json:"uid"`
+	URL           string       `json:"url"`
+	Title         string       `json:"title"`
+	LastBuildDate string       `json:"lastBuildDate"`
+	IsLoading     bool         `json:"isLoading"`
+	HasError      bool         `json:"hasError"`
+	Articles      []RSSArticle `json:"articles"`
+}
+
+// UnreadCount returns the number of unread articles
+func (f RSSFeed) UnreadCount() int {
+	count := 0
+	for _, article := range f.Articles {
+		if !article.IsRead {
+			count++
+		}
+	}
+	return count
+}
+
+// parseRSSItems flattens the folder tree returned by /api/v2/rss/items into
+// its feeds, sorted by path. Folders are objects of items, feeds are objects
+// with a url.
+func parseRSSItems(items map[string]json.RawMessage, folder string) ([]RSSFeed, error) {
+	var feeds []RSSFeed
+	for name, raw := range items {
+		path := name
+		if folder != "" {
+			path = folder + RSSPathSeparator + name
+		}
+
+		var probe struct {
+			URL *string `json:"url"`
+		}
+		if err := json.Unmarshal(raw, &probe); err != nil {
+			return nil, fmt.Errorf("invalid RSS item %q: %w", path, err)
+		}
+
+		if probe.URL != nil {
+			var feed RSSFeed
+			if err := json.Unmarshal(raw, &feed); err != nil {
+				return nil, fmt.Errorf("invalid RSS feed %q: %w", path, err)
+			}
+			feed.Path = path
+			feeds = append(feeds, feed)
+			continue
+		}
+
+		var children map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &children); err != nil {
+			return nil, fmt.Errorf("invalid RSS folder %q: %w", path, err)
+		}
+		nested, err := parseRSSItems(children, path)
+		if err != nil {
+			return nil, err
+		}
+		feeds = append(feeds, nested...)
+	}
+
+	sort.Slice(feeds, func(i, j int) bool {
+		return strings.ToLower(feeds[i].Path) < strings.ToLower(feeds[j].Path)
+	})
+	return feeds, nil
+}
+
+// RSSRule is an RSS auto-download rule. Settings the TUI doesn't edit are
+// kept as they came from the server so saving a rule doesn't reset them.
+type RSSRule struct {
+	Enabled          bool     `json:"enabled"`
+	MustContain      string   `json:"mustContain"`
+	MustNotContain   string   `json:"mustNotContain"`
+	UseRegex         bool     `json:"useRegex"`
+	EpisodeFilter    string   `json:"episodeFilter"`
+	SmartFilter      bool     `json:"smartFilter"`
+	AffectedFeeds    []string `json:"affectedFeeds"` // Feed URLs
+	IgnoreDays       int      `json:"ignoreDays"`
+	LastMatch        string   `json:"lastMatch"`
+	SavePath         string   `json:"savePath"`
+	AssignedCategory string   `json:"assignedCategory"`
+
+	extra map[string]json.RawMessage // Server settings not modelled above
+}
+
+// rssRuleFields is RSSRule without its JSON methods
+type rssRuleFields RSSRule
+
+// UnmarshalJSON decodes a rule, keeping unknown settings for MarshalJSON
+func (r *RSSRule) UnmarshalJSON(data []byte) error {
+	var fields rssRuleFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	known, _ := json.Marshal(rssRuleFields{})
+	var knownKeys map[string]json.RawMessage
+	_ = json.Unmarshal(known, &knownKeys)
+	for key := range knownKeys {
+		delete(all, key)
+	}
+
+	*r = RSSRule(fields)
+	if len(all) > 0 {
+		r.extra = all
+	}
+	return nil
+}
+
+// MarshalJSON encodes a rule, including the settings it didn't model
+func (r RSSRule) MarshalJSON() ([]byte, error) {
+	if r.AffectedFeeds == nil {
+		r.AffectedFeeds = []string{}
+	}
+	data, err := json.Marshal(rssRuleFields(r))
+	if err != nil || len(r.extra) == 0 {
+		return data, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for key, value := range r.extra {
+		if _, ok := all[key]; !ok {
+			all[key] = value
+		}
+	}
+	return json.Marshal(all)
+}
+
+// Matches reports whether an article title passes the rule's must contain and
+// must not contain expressions, the way qBittorrent evaluates them. Without
+// regex mode an expression is a list of alternatives separated by "|", each
+// matching when all its space-separated wildcard words (* and ?) appear in the
+// title. Episode and smart filters are only applied by the server.
+func (r RSSRule) Matches(title string) (bool, error) {
+	contains, err := r.matchesExpression(title, r.MustContain, true)
+	if err != nil || !contains {
+		return false, err
+	}
+	excluded, err := r.matchesExpression(title, r.MustNotContain, false)
+	if err != nil {
+		return false, err
+	}
+	return !excluded, nil
+}
+
+// matchesExpression reports whether title matches any alternative of the
+// expression; an empty expression matches when emptyMatches is set
+func (r RSSRule) matchesExpression(title, expression string, emptyMatches bool) (bool, error) {
+	if expression == "" {
+		return emptyMatches, nil
+	}
+
+	if r.UseRegex {
+		re, err := regexp.Compile("(?i)" + expression)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %q: %w", expression, err)
+		}
+		return re.MatchString(title), nil
+	}
+
+	for _, alternative := range strings.Split(expression, "|") {
+		words := strings.Fields(alternative)
+		if len(words) == 0 {
+			// "expr|" matches everything, like the equivalent regex
+			return true, nil
+		}
+		matched := true
+		for _, word := range words {
+			if !wildcardRegexp(word).MatchString(title) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// wildcardRegexp converts a wildcard word to an unanchored, case-insensitive
+// regular expression
+func wildcardRegexp(word string) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("(?i)")
+	for _, r := range word {
+		switch r {
+		case '*':
+			pattern.WriteString(".*")
+		case '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return regexp.MustCompile(pattern.String())
+}
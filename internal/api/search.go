@@ -0,0 +1,64 @@
+package api
+
+import "encoding/json"
+
+// Search job states reported by the search API
+const (
+	SearchStatusRunning = "Running"
+	SearchStatusStopped = "Stopped"
+)
+
+// SearchStatus is the state of a search job
+type SearchStatus struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Total  int    `json:"total"` // Results found so far
+}
+
+// SearchResult is a torrent found by a search plugin
+type SearchResult struct {
+	FileName   string `json:"fileName"`
+	FileURL    string `json:"fileUrl"` // Torrent file or magnet link
+	FileSize   int64  `json:"fileSize"`
+	Seeders    int    `json:"nbSeeders"`
+	Leechers   int    `json:"nbLeechers"`
+	SiteURL    string `json:"siteUrl"`
+	DescrLink  string `json:"descrLink"`
+	EngineName string `json:"engineName"` // qBittorrent 5.0+
+	PubDate    int64  `json:"pubDate"`    // qBittorrent 5.0+
+}
+
+// SearchResults is a page of a search job's results
+type SearchResults struct {
+	Results []SearchResult `json:"results"`
+	Status  string         `json:"status"`
+	Total   int            `json:"total"`
+}
+
+// SearchCategory is a category a search plugin supports
+type SearchCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON accepts both category objects and the plain category names
+// servers before qBittorrent 4.3 send
+func (c *SearchCategory) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*c = SearchCategory{ID: name, Name: name}
+		return nil
+	}
+	type category SearchCategory
+	return json.Unmarshal(data, (*category)(c))
+}
+
+// SearchPlugin is an installed search plugin
+type SearchPlugin struct {
+	Name                string           `json:"name"`
+	FullName            string           `json:"fullName"`
+	Version             string           `json:"version"`
+	URL                 string           `json:"url"`
+	Enabled             bool             `json:"enabled"`
+	SupportedCategories []SearchCategory `json:"supportedCategories"`
+}
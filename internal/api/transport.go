@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultTimeout bounds a whole request, including reading the response
+	defaultTimeout = 10 * time.Second
+	// defaultConnectTimeout matches net/http's default transport
+	defaultConnectTimeout = 30 * time.Second
+
+	// unixSocketBaseURL addresses the server behind a Unix socket. Its host
+	// is what the Host header, Referer and session cookie all agree on.
+	unixSocketBaseURL = "http://localhost"
+)
+
+// TLSOptions configures TLS for HTTPS servers
+type TLSOptions struct {
+	CAFile             string // PEM certificates trusted in addition to the system roots
+	CertFile           string // Client certificate, PEM, together with KeyFile
+	KeyFile            string
+	InsecureSkipVerify bool   // Accept any server certificate
+	ServerName         string // Expected server name, if it differs from the URL host
+}
+
+// ClientOptions configures how a Client connects to the server. Zero values
+// keep the defaults.
+type ClientOptions struct {
+	// APIKey authenticates with qBittorrent's stateless API keys (≥5.2.0)
+	// instead of Login
+	APIKey string
+
+	TLS TLSOptions
+
+	// Proxy routes requests through an http://, https:// or socks5:// proxy
+	// instead of the one from the environment (HTTP_PROXY etc.)
+	Proxy string
+
+	Timeout               time.Duration // Whole request, 10s by default
+	ConnectTimeout        time.Duration // Establishing the TCP connection
+	ResponseHeaderTimeout time.Duration // Waiting for the response headers
+}
+
+// resolveServerURL returns the base URL for requests and, for
+// unix:///path/to/socket server URLs, the socket to connect to
+func resolveServerURL(serverURL string) (baseURL, socketPath string, err error) {
+	if !strings.HasPrefix(serverURL, "unix:") {
+		return strings.TrimRight(serverURL, "/"), "", nil
+	}
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Path == "" || u.Host != "" {
+		return "", "", NewValidationError("invalid Unix socket URL, expected unix:///path/to/socket", err)
+	}
+	return unixSocketBaseURL, u.Path, nil
+}
+
+// parseProxyURL validates a proxy URL
+func parseProxyURL(proxy string) (*url.URL, error) {
+	u, err := url.Parse(proxy)
+	if err != nil || u.Host == "" {
+		return nil, NewValidationError("invalid proxy URL", err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+		return u, nil
+	}
+	return nil, NewValidationError("unsupported proxy scheme "+u.Scheme+", expected http, https or socks5", nil)
+}
+
+// newTransport builds the HTTP transport for opts on top of net/http's
+// default transport settings, connecting through socketPath if set
+func newTransport(opts ClientOptions, socketPath string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := newTLSConfig(opts.TLS)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	dialer := &net.Dialer{Timeout: opts.ConnectTimeout, KeepAlive: 30 * time.Second}
+	if dialer.Timeout <= 0 {
+		dialer.Timeout = defaultConnectTimeout
+	}
+	transport.DialContext = dialer.DialContext
+	transport.ResponseHeaderTimeout = opts.ResponseHeaderTimeout
+
+	switch {
+	case socketPath != "" && opts.Proxy != "":
+		return nil, NewValidationError("a proxy cannot be used with a Unix socket", nil)
+	case socketPath != "":
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}
+	case opts.Proxy != "":
+		proxyURL, err := parseProxyURL(opts.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// newTLSConfig builds the TLS configuration, nil when opts are all defaults
+func newTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	if opts == (TLSOptions{}) {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+		ServerName:         opts.ServerName,
+	}
+
+	if opts.CAFile != "" {
+		pem, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, NewValidationError("failed to read CA file", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, NewValidationError("no certificates found in CA file "+opts.CAFile, nil)
+		}
+		config.RootCAs = pool
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, NewValidationError("failed to load client certificate", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
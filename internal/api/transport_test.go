@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePEM writes a PEM block to a file in dir and returns its path
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	return path
+}
+
+// newClientCert creates a self-signed client certificate, returning the
+// certificate and the paths of its PEM certificate and key files
+func newClientCert(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	return cert, writePEM(t, dir, "client.pem", "CERTIFICATE", der), writePEM(t, dir, "client-key.pem", "EC PRIVATE KEY", keyDER)
+}
+
+func TestClientTLSOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`["hd"]`))
+	}))
+	defer server.Close()
+	caFile := writePEM(t, t.TempDir(), "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+
+	tests := []struct {
+		name    string
+		tls     TLSOptions
+		wantErr bool
+	}{
+		{name: "untrusted certificate", wantErr: true},
+		{name: "trusted CA file", tls: TLSOptions{CAFile: caFile}},
+		{name: "insecure skip verify", tls: TLSOptions{InsecureSkipVerify: true}},
+		// The httptest certificate is issued for example.com
+		{name: "server name override", tls: TLSOptions{CAFile: caFile, ServerName: "example.com"}},
+		{name: "wrong server name", tls: TLSOptions{CAFile: caFile, ServerName: "qbittorrent.invalid"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientWithOptions(server.URL, ClientOptions{TLS: tt.tls})
+			require.NoError(t, err)
+
+			_, err = client.GetTags(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestClientCertificate(t *testing.T) {
+	cert, certFile, keyFile := newClientCert(t)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClientWithOptions(server.URL, ClientOptions{TLS: TLSOptions{InsecureSkipVerify: true}})
+	require.NoError(t, err)
+	_, err = client.GetTags(context.Background())
+	assert.Error(t, err, "server should reject clients without a certificate")
+
+	client, err = NewClientWithOptions(server.URL, ClientOptions{
+		TLS: TLSOptions{InsecureSkipVerify: true, CertFile: certFile, KeyFile: keyFile},
+	})
+	require.NoError(t, err)
+	_, err = client.GetTags(context.Background())
+	assert.NoError(t, err)
+
+	// The API key transport wraps the configured TLS transport
+	client, err = NewClientWithOptions(server.URL, ClientOptions{
+		APIKey: "qbt_key",
+		TLS:    TLSOptions{InsecureSkipVerify: true, CertFile: certFile, KeyFile: keyFile},
+	})
+	require.NoError(t, err)
+	_, err = client.GetTags(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestClientOptionsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	notPEM := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a certificate"), 0o600))
+
+	for _, opts := range []TLSOptions{
+		{CAFile: filepath.Join(dir, "missing.pem")},
+		{CAFile: notPEM},
+		{CertFile: notPEM, KeyFile: notPEM},
+	} {
+		_, err := NewClientWithOptions("https://localhost:8080", ClientOptions{TLS: opts})
+		require.Error(t, err)
+		assert.Equal(t, ErrorTypeValidation, err.(*APIError).Type)
+	}
+}
+
+func TestClientTimeoutOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(server.URL, ClientOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultTimeout, client.httpClient.Timeout)
+
+	client, err = NewClientWithOptions(server.URL, ClientOptions{ResponseHeaderTimeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+	_, err = client.GetTags(context.Background())
+	assert.Error(t, err, "slow response headers should time out")
+
+	client, err = NewClientWithOptions(server.URL, ClientOptions{Timeout: 50 * time.Millisecond})
+	require.NoError(t, err)
+	_, err = client.GetTags(context.Background())
+	assert.True(t, IsTimeoutError(err), "whole request should time out: %v", err)
+}
+
+// newSessionHandler serves login and torrents/info like qBittorrent,
+// including its check that the Referer matches the Host header
+func newSessionHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "http://"+r.Host, r.Header.Get("Referer"), "Referer should match the Host header")
+		switch r.URL.Path {
+		case "/api/v2/auth/login":
+			http.SetCookie(w, &http.Cookie{Name: "SID", Value: "session", Path: "/"})
+			w.Write([]byte("Ok."))
+		case "/api/v2/torrents/info":
+			if cookie, err := r.Cookie("SID"); err != nil || cookie.Value != "session" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Write([]byte(`[{"hash":"abc123","name":"Ubuntu"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+// serveUnixSocket serves handler on a Unix socket, returning its path
+func serveUnixSocket(t *testing.T, handler http.Handler) string {
+	t.Helper()
+	socket := filepath.Join(t.TempDir(), "qbt.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(handler)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+	return socket
+}
+
+func TestClientUnixSocket(t *testing.T) {
+	socket := serveUnixSocket(t, newSessionHandler(t))
+	client, err := NewClient("unix://" + socket)
+	require.NoError(t, err)
+	require.NoError(t, client.Login("admin", "password"))
+	torrents, err := client.GetTorrents(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, torrents, 1)
+
+	// The API key is sent over the socket too
+	socket = serveUnixSocket(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer qbt_key", r.Header.Get("Authorization"))
+		w.Write([]byte(`[]`))
+	}))
+	client, err = NewClientWithAPIKey("unix://"+socket, "qbt_key")
+	require.NoError(t, err)
+	_, err = client.GetTorrents(context.Background())
+	require.NoError(t, err)
+}
+
+func TestClientProxy(t *testing.T) {
+	// The proxy answers in place of the server it is asked to reach
+	var proxiedHosts []string
+	handler := newSessionHandler(t)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHosts = append(proxiedHosts, r.URL.Host)
+		handler.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	client, err := NewClientWithOptions("http://qbittorrent.invalid:8080", ClientOptions{Proxy: proxy.URL})
+	require.NoError(t, err)
+	require.NoError(t, client.Login("admin", "password"))
+	torrents, err := client.GetTorrents(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, torrents, 1)
+	assert.Equal(t, []string{"qbittorrent.invalid:8080", "qbittorrent.invalid:8080"}, proxiedHosts)
+}
+
+func TestClientConnectionOptionsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		options ClientOptions
+	}{
+		{name: "unix socket without path", url: "unix://"},
+		{name: "unix socket with host", url: "unix://host/qbt.sock"},
+		{name: "proxy with unix socket", url: "unix:///run/qbt.sock", options: ClientOptions{Proxy: "socks5://localhost:1080"}},
+		{name: "unsupported proxy scheme", url: "http://localhost:8080", options: ClientOptions{Proxy: "ftp://localhost:21"}},
+		{name: "proxy without host", url: "http://localhost:8080", options: ClientOptions{Proxy: "socks5://"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClientWithOptions(tt.url, tt.options)
+			require.Error(t, err)
+			assert.Equal(t, ErrorTypeValidation, err.(*APIError).Type)
+		})
+	}
+
+	_, err := NewClientWithOptions("http://localhost:8080", ClientOptions{Proxy: "socks5://localhost:1080"})
+	assert.NoError(t, err, "socks5 proxies are supported")
+}
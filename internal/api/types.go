@@ -1,5 +1,7 @@
 package api
 
+import "strings"
+
 type Torrent struct {
 	Hash             string  `json:"hash"`
 	Name             string  `json:"name"`
@@ -26,20 +28,34 @@ type Torrent struct {
 	RemainingSize    int64   `json:"amount_left"`
 	TimeActive       int64   `json:"time_active"`
 	AutoTMM          bool    `json:"auto_tmm"`
+	ForceStart       bool    `json:"force_start"`
+	SeqDownload      bool    `json:"seq_dl"`
+	FirstLastPiece   bool    `json:"f_l_piece_prio"`
 	TotalSize        int64   `json:"total_size"`
 	MaxRatio         float64 `json:"max_ratio"`
 	MaxSeedingTime   int64   `json:"max_seeding_time"`
 	SeedingTimeLimit int64   `json:"seeding_time_limit"`
+	DlLimit          int64   `json:"dl_limit"` // Bytes/s, 0 or -1 when unlimited
+	UpLimit          int64   `json:"up_limit"` // Bytes/s, 0 or -1 when unlimited
+
+	// Share limit settings (-2 follows the global limit, -1 is unlimited);
+	// MaxRatio, MaxSeedingTime and MaxInactiveSeedingTime are the effective values
+	RatioLimit               float64 `json:"ratio_limit"`
+	InactiveSeedingTimeLimit int64   `json:"inactive_seeding_time_limit"`
+	MaxInactiveSeedingTime   int64   `json:"max_inactive_seeding_time"`
 }
 
 type GlobalStats struct {
-	DlInfoSpeed      int64  `json:"dl_info_speed"`
-	UpInfoSpeed      int64  `json:"up_info_speed"`
-	DlInfoData       int64  `json:"dl_info_data"`
-	UpInfoData       int64  `json:"up_info_data"`
-	ConnectionStatus string `json:"connection_status"`
-	DHTNodes         int64  `json:"dht_nodes"`
-	FreeSpaceOnDisk  int64  `json:"free_space_on_disk"` // From /api/v2/sync/maindata
+	DlInfoSpeed       int64  `json:"dl_info_speed"`
+	UpInfoSpeed       int64  `json:"up_info_speed"`
+	DlInfoData        int64  `json:"dl_info_data"`
+	UpInfoData        int64  `json:"up_info_data"`
+	ConnectionStatus  string `json:"connection_status"`
+	DHTNodes          int64  `json:"dht_nodes"`
+	FreeSpaceOnDisk   int64  `json:"free_space_on_disk"`   // From /api/v2/sync/maindata
+	DlRateLimit       int64  `json:"dl_rate_limit"`        // Global download limit in bytes/s, 0 when unlimited
+	UpRateLimit       int64  `json:"up_rate_limit"`        // Global upload limit in bytes/s, 0 when unlimited
+	UseAltSpeedLimits bool   `json:"use_alt_speed_limits"` // Alternative speed limits ("turtle mode") active
 }
 
 // MainData represents the response from /api/v2/sync/maindata
@@ -50,13 +66,16 @@ type MainData struct {
 // ServerState contains server state information including free disk space.
 // Uses pointer types to distinguish between "field not present" (nil) and "field is zero value".
 type ServerState struct {
-	ConnectionStatus *string `json:"connection_status"`
-	DHTNodes         *int64  `json:"dht_nodes"`
-	DlInfoSpeed      *int64  `json:"dl_info_speed"`
-	UpInfoSpeed      *int64  `json:"up_info_speed"`
-	DlInfoData       *int64  `json:"dl_info_data"`
-	UpInfoData       *int64  `json:"up_info_data"`
-	FreeSpaceOnDisk  *int64  `json:"free_space_on_disk"`
+	ConnectionStatus  *string `json:"connection_status"`
+	DHTNodes          *int64  `json:"dht_nodes"`
+	DlInfoSpeed       *int64  `json:"dl_info_speed"`
+	UpInfoSpeed       *int64  `json:"up_info_speed"`
+	DlInfoData        *int64  `json:"dl_info_data"`
+	UpInfoData        *int64  `json:"up_info_data"`
+	FreeSpaceOnDisk   *int64  `json:"free_space_on_disk"`
+	DlRateLimit       *int64  `json:"dl_rate_limit"`
+	UpRateLimit       *int64  `json:"up_rate_limit"`
+	UseAltSpeedLimits *bool   `json:"use_alt_speed_limits"`
 }
 
 // SyncMainDataResponse represents the full response from /api/v2/sync/maindata
@@ -102,10 +121,19 @@ type PartialTorrent struct {
 	RemainingSize    *int64   `json:"amount_left"`
 	TimeActive       *int64   `json:"time_active"`
 	AutoTMM          *bool    `json:"auto_tmm"`
+	ForceStart       *bool    `json:"force_start"`
+	SeqDownload      *bool    `json:"seq_dl"`
+	FirstLastPiece   *bool    `json:"f_l_piece_prio"`
 	TotalSize        *int64   `json:"total_size"`
 	MaxRatio         *float64 `json:"max_ratio"`
 	MaxSeedingTime   *int64   `json:"max_seeding_time"`
 	SeedingTimeLimit *int64   `json:"seeding_time_limit"`
+	DlLimit          *int64   `json:"dl_limit"`
+	UpLimit          *int64   `json:"up_limit"`
+
+	RatioLimit               *float64 `json:"ratio_limit"`
+	InactiveSeedingTimeLimit *int64   `json:"inactive_seeding_time_limit"`
+	MaxInactiveSeedingTime   *int64   `json:"max_inactive_seeding_time"`
 }
 
 // ApplyTo merges the partial torrent data into an existing torrent.
@@ -186,6 +214,15 @@ func (p *PartialTorrent) ApplyTo(t *Torrent) {
 	if p.AutoTMM != nil {
 		t.AutoTMM = *p.AutoTMM
 	}
+	if p.ForceStart != nil {
+		t.ForceStart = *p.ForceStart
+	}
+	if p.SeqDownload != nil {
+		t.SeqDownload = *p.SeqDownload
+	}
+	if p.FirstLastPiece != nil {
+		t.FirstLastPiece = *p.FirstLastPiece
+	}
 	if p.TotalSize != nil {
 		t.TotalSize = *p.TotalSize
 	}
@@ -198,6 +235,21 @@ func (p *PartialTorrent) ApplyTo(t *Torrent) {
 	if p.SeedingTimeLimit != nil {
 		t.SeedingTimeLimit = *p.SeedingTimeLimit
 	}
+	if p.DlLimit != nil {
+		t.DlLimit = *p.DlLimit
+	}
+	if p.UpLimit != nil {
+		t.UpLimit = *p.UpLimit
+	}
+	if p.RatioLimit != nil {
+		t.RatioLimit = *p.RatioLimit
+	}
+	if p.InactiveSeedingTimeLimit != nil {
+		t.InactiveSeedingTimeLimit = *p.InactiveSeedingTimeLimit
+	}
+	if p.MaxInactiveSeedingTime != nil {
+		t.MaxInactiveSeedingTime = *p.MaxInactiveSeedingTime
+	}
 }
 
 // ToTorrent converts a PartialTorrent to a full Torrent.
@@ -263,6 +315,12 @@ type Tracker struct {
 	Msg           string `json:"msg"`
 }
 
+// IsPseudoTracker reports whether the tracker is one of the DHT, PeX and LSD
+// entries qBittorrent lists alongside real trackers, which cannot be edited
+func (t Tracker) IsPseudoTracker() bool {
+	return strings.HasPrefix(t.URL, "** [")
+}
+
 // Peer represents a torrent peer
 type Peer struct {
 	IP          string  `json:"ip"`
@@ -280,6 +338,96 @@ type Peer struct {
 	FilesString string  `json:"files"`
 }
 
+// SyncTorrentPeersResponse represents the response from /api/v2/sync/torrentPeers.
+// Like sync/maindata, rid=0 returns every peer and later requests only return
+// peers whose fields changed, with disconnected peers listed in PeersRemoved.
+type SyncTorrentPeersResponse struct {
+	RID          int                    `json:"rid"`           // Response ID for tracking incremental updates
+	FullUpdate   bool                   `json:"full_update"`   // Whether Peers holds every peer (true) or only changes (false)
+	Peers        map[string]PartialPeer `json:"peers"`         // Map of "ip:port" -> peer data (only changed fields in incremental updates)
+	PeersRemoved []string               `json:"peers_removed"` // Peers disconnected since the last request
+	ShowFlags    bool                   `json:"show_flags"`    // Whether the server resolves peer countries
+}
+
+// PartialPeer represents peer data from sync/torrentPeers incremental updates.
+// Like PartialTorrent, nil fields were not present in the JSON.
+type PartialPeer struct {
+	IP          *string  `json:"ip"`
+	Port        *int     `json:"port"`
+	Country     *string  `json:"country"`
+	Connection  *string  `json:"connection"`
+	Flags       *string  `json:"flags"`
+	Client      *string  `json:"client"`
+	Progress    *float64 `json:"progress"`
+	DlSpeed     *int64   `json:"dl_speed"`
+	UpSpeed     *int64   `json:"up_speed"`
+	Downloaded  *int64   `json:"downloaded"`
+	Uploaded    *int64   `json:"uploaded"`
+	Relevance   *float64 `json:"relevance"`
+	FilesString *string  `json:"files"`
+}
+
+// ApplyTo merges the partial peer data into an existing peer.
+// Only fields that are non-nil (i.e., were present in the JSON) are updated.
+func (p *PartialPeer) ApplyTo(peer *Peer) {
+	if p.IP != nil {
+		peer.IP = *p.IP
+	}
+	if p.Port != nil {
+		peer.Port = *p.Port
+	}
+	if p.Country != nil {
+		peer.Country = *p.Country
+	}
+	if p.Connection != nil {
+		peer.Connection = *p.Connection
+	}
+	if p.Flags != nil {
+		peer.Flags = *p.Flags
+	}
+	if p.Client != nil {
+		peer.Client = *p.Client
+	}
+	if p.Progress != nil {
+		peer.Progress = *p.Progress
+	}
+	if p.DlSpeed != nil {
+		peer.DlSpeed = *p.DlSpeed
+	}
+	if p.UpSpeed != nil {
+		peer.UpSpeed = *p.UpSpeed
+	}
+	if p.Downloaded != nil {
+		peer.Downloaded = *p.Downloaded
+	}
+	if p.Uploaded != nil {
+		peer.Uploaded = *p.Uploaded
+	}
+	if p.Relevance != nil {
+		peer.Relevance = *p.Relevance
+	}
+	if p.FilesString != nil {
+		peer.FilesString = *p.FilesString
+	}
+}
+
+// ApplyPeerSync merges a sync/torrentPeers response into the peer map and
+// returns it; a full update replaces the map
+func ApplyPeerSync(peers map[string]Peer, update *SyncTorrentPeersResponse) map[string]Peer {
+	if update.FullUpdate || peers == nil {
+		peers = make(map[string]Peer, len(update.Peers))
+	}
+	for id, partial := range update.Peers {
+		peer := peers[id]
+		partial.ApplyTo(&peer)
+		peers[id] = peer
+	}
+	for _, id := range update.PeersRemoved {
+		delete(peers, id)
+	}
+	return peers
+}
+
 // TorrentFile represents a file within a torrent
 type TorrentFile struct {
 	Index        int     `json:"index"`
@@ -292,6 +440,49 @@ type TorrentFile struct {
 	Availability float64 `json:"availability"`
 }
 
+// Special share limit values accepted by SetShareLimits
+const (
+	ShareLimitGlobal    = -2 // Follow the global share limit
+	ShareLimitUnlimited = -1 // No limit
+)
+
+// ShareLimits holds the seeding limits applied by SetShareLimits. Each value
+// is ShareLimitGlobal, ShareLimitUnlimited or a custom limit.
+type ShareLimits struct {
+	RatioLimit               float64
+	SeedingTimeLimit         int64 // Minutes
+	InactiveSeedingTimeLimit int64 // Minutes, ignored by servers older than 4.6
+}
+
+// Content layouts accepted by AddTorrentOptions
+const (
+	ContentLayoutOriginal    = "Original"
+	ContentLayoutSubfolder   = "Subfolder"
+	ContentLayoutNoSubfolder = "NoSubfolder"
+)
+
+// AddTorrentOptions holds the settings for a torrent being added. Zero
+// values leave the server's defaults in place.
+type AddTorrentOptions struct {
+	SavePath      string
+	Category      string // Created by the server if it doesn't exist
+	Tags          []string
+	Paused        bool
+	SkipChecking  bool
+	Sequential    bool
+	ContentLayout string // One of the ContentLayout constants
+	Rename        string
+	Limits        *ShareLimits // nil keeps the global share limits
+}
+
+// File download priorities accepted by SetFilePriority
+const (
+	FilePrioritySkip    = 0
+	FilePriorityNormal  = 1
+	FilePriorityHigh    = 6
+	FilePriorityMaximum = 7
+)
+
 type TorrentState string
 
 const (
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
@@ -15,11 +16,32 @@ import (
 
 // ServerConfig holds qBittorrent connection settings. Authenticate with
 // either Username+Password OR APIKey (qBittorrent ≥5.2.0) — not both.
+// URL may be unix:///path/to/socket for a server on a Unix socket.
 type ServerConfig struct {
 	URL      string `mapstructure:"url"`
 	Username string `mapstructure:"username"`
 	Password string `mapstructure:"password"`
 	APIKey   string `mapstructure:"api_key"`
+	Proxy    string `mapstructure:"proxy"` // http://, https:// or socks5:// proxy URL
+
+	// Timeouts in seconds; 0 keeps the default: 10 seconds for the whole
+	// request and 30 for connecting, which the whole request timeout still
+	// caps. The response headers have no limit beyond the whole request.
+	Timeout               int `mapstructure:"timeout"`                 // Whole request
+	ConnectTimeout        int `mapstructure:"connect_timeout"`         // Establishing the connection
+	ResponseHeaderTimeout int `mapstructure:"response_header_timeout"` // Waiting for the response headers
+
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// TLSConfig holds TLS settings for HTTPS servers, e.g. behind a reverse
+// proxy with a self-signed certificate or client certificate auth
+type TLSConfig struct {
+	CAFile             string `mapstructure:"ca_file"`              // PEM certificates to trust
+	CertFile           string `mapstructure:"cert_file"`            // PEM client certificate
+	KeyFile            string `mapstructure:"key_file"`             // PEM client key
+	InsecureSkipVerify bool   `mapstructure:"insecure_skip_verify"` // Accept any server certificate
+	ServerName         string `mapstructure:"server_name"`          // Expected name on the server certificate
 }
 
 type Config struct {
@@ -53,6 +75,7 @@ func Load(cmd *cobra.Command) (*Config, error) {
 	viper.AddConfigPath(filepath.Join(os.Getenv("HOME"), ".config", "qbt-tui"))
 
 	// Set defaults
+	viper.SetDefault("server.timeout", 10)
 	viper.SetDefault("ui.refresh_interval", 3)
 	viper.SetDefault("ui.terminal_title.enabled", false)
 	viper.SetDefault("ui.terminal_title.template", "qbt-tui [{active_torrents}/{total_torrents}] ↓{dl_speed} ↑{up_speed}")
@@ -69,6 +92,15 @@ func Load(cmd *cobra.Command) (*Config, error) {
 	viper.BindEnv("server.username", "QBT_SERVER_USERNAME")
 	viper.BindEnv("server.password", "QBT_SERVER_PASSWORD")
 	viper.BindEnv("server.api_key", "QBT_SERVER_API_KEY")
+	viper.BindEnv("server.proxy", "QBT_SERVER_PROXY")
+	viper.BindEnv("server.timeout", "QBT_SERVER_TIMEOUT")
+	viper.BindEnv("server.connect_timeout", "QBT_SERVER_CONNECT_TIMEOUT")
+	viper.BindEnv("server.response_header_timeout", "QBT_SERVER_RESPONSE_HEADER_TIMEOUT")
+	viper.BindEnv("server.tls.ca_file", "QBT_SERVER_TLS_CA_FILE")
+	viper.BindEnv("server.tls.cert_file", "QBT_SERVER_TLS_CERT_FILE")
+	viper.BindEnv("server.tls.key_file", "QBT_SERVER_TLS_KEY_FILE")
+	viper.BindEnv("server.tls.insecure_skip_verify", "QBT_SERVER_TLS_INSECURE_SKIP_VERIFY")
+	viper.BindEnv("server.tls.server_name", "QBT_SERVER_TLS_SERVER_NAME")
 	viper.BindEnv("ui.refresh_interval", "QBT_UI_REFRESH_INTERVAL")
 	viper.BindEnv("ui.columns", "QBT_UI_COLUMNS")
 	viper.BindEnv("ui.default_sort.column", "QBT_UI_DEFAULT_SORT_COLUMN")
@@ -110,6 +142,9 @@ func Load(cmd *cobra.Command) (*Config, error) {
 		if err := bindFlag("server.api_key", "api-key"); err != nil {
 			return nil, err
 		}
+		if err := bindFlag("server.proxy", "proxy"); err != nil {
+			return nil, err
+		}
 		if err := bindFlag("ui.refresh_interval", "refresh"); err != nil {
 			return nil, err
 		}
@@ -142,6 +177,10 @@ func (c *Config) validate() error {
 		return fmt.Errorf("server.api_key cannot be combined with server.username or server.password — choose one auth method")
 	}
 
+	if err := c.Server.validateTransport(); err != nil {
+		return err
+	}
+
 	if c.UI.RefreshInterval < 1 {
 		return fmt.Errorf("ui.refresh_interval must be at least 1 second")
 	}
@@ -171,3 +210,60 @@ func (c *Config) validate() error {
 
 	return nil
 }
+
+// validateTransport checks the server URL scheme, proxy, timeout and TLS
+// settings
+func (s *ServerConfig) validateTransport() error {
+	serverURL, err := url.Parse(s.URL)
+	if err != nil {
+		return fmt.Errorf("server.url is invalid: %w", err)
+	}
+	switch serverURL.Scheme {
+	case "http", "https":
+	case "unix":
+		if serverURL.Path == "" || serverURL.Host != "" {
+			return fmt.Errorf("server.url for a Unix socket must look like unix:///path/to/socket")
+		}
+		if s.Proxy != "" {
+			return fmt.Errorf("server.proxy cannot be used with a Unix socket server.url")
+		}
+	default:
+		return fmt.Errorf("server.url must start with http://, https:// or unix://")
+	}
+
+	if s.Proxy != "" {
+		proxyURL, err := url.Parse(s.Proxy)
+		if err != nil || proxyURL.Host == "" {
+			return fmt.Errorf("server.proxy must be a URL like socks5://localhost:1080")
+		}
+		if !slices.Contains([]string{"http", "https", "socks5", "socks5h"}, proxyURL.Scheme) {
+			return fmt.Errorf("server.proxy must start with http://, https:// or socks5://")
+		}
+	}
+
+	if s.Timeout < 0 || s.ConnectTimeout < 0 || s.ResponseHeaderTimeout < 0 {
+		return fmt.Errorf("server timeouts cannot be negative")
+	}
+
+	tls := s.TLS
+	if (tls.CertFile == "") != (tls.KeyFile == "") {
+		return fmt.Errorf("server.tls.cert_file and server.tls.key_file must be set together")
+	}
+	if tls.InsecureSkipVerify && tls.CAFile != "" {
+		return fmt.Errorf("server.tls.ca_file has no effect with server.tls.insecure_skip_verify — choose one")
+	}
+	for key, path := range map[string]string{
+		"server.tls.ca_file":   tls.CAFile,
+		"server.tls.cert_file": tls.CertFile,
+		"server.tls.key_file":  tls.KeyFile,
+	} {
+		if path == "" {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -126,6 +126,60 @@ api_key = "qbt_testkeytestkeytestkeytestkey"`,
 			wantErr:     true,
 			errContains: "server.api_key cannot be combined",
 		},
+		{
+			name: "timeouts and tls settings",
+			configData: `[server]
+url = "https://qbittorrent.lan"
+connect_timeout = 3
+
+[server.tls]
+insecure_skip_verify = true
+server_name = "qbittorrent.internal"`,
+			envVars: map[string]string{
+				"QBT_SERVER_TIMEOUT": "30",
+			},
+			wantErr: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 30, cfg.Server.Timeout)
+				assert.Equal(t, 3, cfg.Server.ConnectTimeout)
+				assert.Zero(t, cfg.Server.ResponseHeaderTimeout)
+				assert.True(t, cfg.Server.TLS.InsecureSkipVerify)
+				assert.Equal(t, "qbittorrent.internal", cfg.Server.TLS.ServerName)
+			},
+		},
+		{
+			name: "proxy via env var",
+			configData: `[server]
+url = "http://localhost:8080"`,
+			envVars: map[string]string{
+				"QBT_SERVER_URL":   "https://qbittorrent.lan",
+				"QBT_SERVER_PROXY": "socks5://localhost:1080",
+			},
+			wantErr: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, "https://qbittorrent.lan", cfg.Server.URL)
+				assert.Equal(t, "socks5://localhost:1080", cfg.Server.Proxy)
+			},
+		},
+		{
+			name: "default timeout",
+			configData: `[server]
+url = "http://localhost:8080"`,
+			wantErr: false,
+			validate: func(t *testing.T, cfg *Config) {
+				assert.Equal(t, 10, cfg.Server.Timeout)
+			},
+		},
+		{
+			name: "client cert without key",
+			configData: `[server]
+url = "https://qbittorrent.lan"
+
+[server.tls]
+cert_file = "client.pem"`,
+			wantErr:     true,
+			errContains: "server.tls.cert_file and server.tls.key_file must be set together",
+		},
 	}
 
 	for _, tt := range tests {
@@ -518,6 +572,58 @@ func TestConfigValidation(t *testing.T) {
 	}
 }
 
+func TestServerTransportValidation(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, []byte("certificate"), 0o600))
+
+	tests := []struct {
+		name   string
+		server ServerConfig
+		errMsg string
+	}{
+		{name: "defaults", server: ServerConfig{}},
+		{name: "existing CA file", server: ServerConfig{TLS: TLSConfig{CAFile: caFile}}},
+		{name: "client certificate", server: ServerConfig{TLS: TLSConfig{CertFile: caFile, KeyFile: caFile}}},
+		{name: "negative timeout", server: ServerConfig{ConnectTimeout: -1}, errMsg: "cannot be negative"},
+		{name: "missing CA file", server: ServerConfig{TLS: TLSConfig{CAFile: filepath.Join(dir, "missing.pem")}}, errMsg: "server.tls.ca_file"},
+		{name: "key without cert", server: ServerConfig{TLS: TLSConfig{KeyFile: caFile}}, errMsg: "must be set together"},
+		{
+			name:   "CA file with insecure skip verify",
+			server: ServerConfig{TLS: TLSConfig{CAFile: caFile, InsecureSkipVerify: true}},
+			errMsg: "has no effect",
+		},
+		{name: "unix socket", server: ServerConfig{URL: "unix:///run/qbittorrent.sock"}},
+		{name: "socks5 proxy", server: ServerConfig{Proxy: "socks5://localhost:1080"}},
+		{name: "https proxy", server: ServerConfig{URL: "https://qbittorrent.lan", Proxy: "https://proxy.lan:3128"}},
+		{name: "unsupported url scheme", server: ServerConfig{URL: "ftp://qbittorrent.lan"}, errMsg: "server.url must start with"},
+		{name: "url without scheme", server: ServerConfig{URL: "localhost:8080"}, errMsg: "server.url"},
+		{name: "unix socket without path", server: ServerConfig{URL: "unix://"}, errMsg: "unix:///path/to/socket"},
+		{
+			name:   "proxy with unix socket",
+			server: ServerConfig{URL: "unix:///run/qbittorrent.sock", Proxy: "socks5://localhost:1080"},
+			errMsg: "cannot be used with a Unix socket",
+		},
+		{name: "unsupported proxy scheme", server: ServerConfig{Proxy: "ftp://localhost:21"}, errMsg: "server.proxy must start with"},
+		{name: "proxy without host", server: ServerConfig{Proxy: "localhost:1080"}, errMsg: "server.proxy must be a URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.server.URL == "" {
+				tt.server.URL = "http://localhost:8080"
+			}
+			err := tt.server.validateTransport()
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestConfigPrecedence(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -591,6 +697,36 @@ refresh_interval = 15`,
 			},
 			description: "Config file values used when no flags or env vars",
 		},
+		{
+			name: "Proxy flag > ENV",
+			configData: `[server]
+url = "http://config:8080"
+proxy = "http://config:3128"`,
+			envVars: map[string]string{
+				"QBT_SERVER_PROXY": "http://env:3128",
+			},
+			flags: map[string]string{
+				"proxy": "socks5://flag:1080",
+			},
+			expected: map[string]interface{}{
+				"server.url":   "http://config:8080",
+				"server.proxy": "socks5://flag:1080", // Flag wins
+			},
+			description: "Proxy flag overrides env var and config file",
+		},
+		{
+			name: "Unix socket URL flag",
+			configData: `[server]
+url = "http://config:8080"`,
+			envVars: map[string]string{},
+			flags: map[string]string{
+				"url": "unix:///run/qbittorrent.sock",
+			},
+			expected: map[string]interface{}{
+				"server.url": "unix:///run/qbittorrent.sock",
+			},
+			description: "Unix socket URLs are accepted for server.url",
+		},
 		{
 			name:        "Defaults only",
 			configData:  "",
@@ -636,6 +772,7 @@ refresh_interval = 15`,
 				cmd.Flags().String("url", "", "")
 				cmd.Flags().String("username", "", "")
 				cmd.Flags().String("password", "", "")
+				cmd.Flags().String("proxy", "", "")
 				cmd.Flags().Int("refresh", 0, "")
 
 				// Set flag values
@@ -666,6 +803,8 @@ refresh_interval = 15`,
 					assert.Equal(t, expectedValue, cfg.Server.Username, tt.description)
 				case "server.password":
 					assert.Equal(t, expectedValue, cfg.Server.Password, tt.description)
+				case "server.proxy":
+					assert.Equal(t, expectedValue, cfg.Server.Proxy, tt.description)
 				case "ui.refresh_interval":
 					assert.Equal(t, expectedValue, cfg.UI.RefreshInterval, tt.description)
 				}
@@ -63,7 +63,7 @@ func (f *Filter) matches(t api.Torrent) bool {
 
 	// Tags filter
 	if len(f.Tags) > 0 {
-		torrentTags := splitTags(t.Tags)
+		torrentTags := SplitTags(t.Tags)
 		if !hasAnyTag(torrentTags, f.Tags) {
 			return false
 		}
@@ -164,7 +164,7 @@ func ExtractUniqueCategories(torrents []api.Torrent) []string {
 func ExtractUniqueTags(torrents []api.Torrent) []string {
 	tags := make(map[string]bool)
 	for _, t := range torrents {
-		torrentTags := splitTags(t.Tags)
+		torrentTags := SplitTags(t.Tags)
 		for _, tag := range torrentTags {
 			if tag != "" {
 				tags[tag] = true
@@ -229,7 +229,8 @@ func extractDomain(tracker string) string {
 	return host
 }
 
-func splitTags(tags string) []string {
+// SplitTags parses qBittorrent's comma-separated torrent tag string
+func SplitTags(tags string) []string {
 	if tags == "" {
 		return nil
 	}
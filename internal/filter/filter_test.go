@@ -302,7 +302,7 @@ func TestSplitTags(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := splitTags(tt.tags)
+			result := SplitTags(tt.tags)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -198,7 +198,7 @@ func formatHashList(hashes []string) string {
 // formatValue formats a field value based on its name for human-readable logging
 func formatValue(fieldName string, value any) any {
 	switch fieldName {
-	case "dlspeed", "upspeed":
+	case "dlspeed", "upspeed", "dl_limit", "up_limit":
 		if v, ok := value.(int64); ok {
 			return formatSpeed(v)
 		}
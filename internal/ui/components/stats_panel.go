@@ -99,7 +99,17 @@ func (s *StatsPanel) renderConnectionStatus() string {
 func (s *StatsPanel) renderTransferStats() string {
 	var lines []string
 
-	lines = append(lines, styles.SubtitleStyle.Render("Transfer"))
+	// Global speed limits are only shown when at least one is set
+	title := styles.SubtitleStyle.Render("Transfer")
+	if s.stats.DlRateLimit > 0 || s.stats.UpRateLimit > 0 {
+		title += styles.DimStyle.Render(fmt.Sprintf(" (limits ↓%s ↑%s)",
+			styles.FormatSpeedLimit(s.stats.DlRateLimit),
+			styles.FormatSpeedLimit(s.stats.UpRateLimit)))
+	}
+	if s.stats.UseAltSpeedLimits {
+		title += " " + styles.WarningStyle.Render("[ALT]")
+	}
+	lines = append(lines, title)
 
 	// Download and Upload speeds on same line for clarity
 	dlSpeed := styles.FormatSpeed(s.stats.DlInfoSpeed)
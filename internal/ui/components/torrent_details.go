@@ -3,7 +3,9 @@ package components
 import (
 	"context"
 	"fmt"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,6 +31,7 @@ type TorrentDetails struct {
 	properties *api.TorrentProperties
 	trackers   []api.Tracker
 	peers      map[string]api.Peer
+	peersRID   int // Last sync/torrentPeers response ID; 0 requests every peer
 	files      []api.TorrentFile
 	client     api.ClientInterface
 	width      int
@@ -37,13 +40,25 @@ type TorrentDetails struct {
 	activeTab  DetailsTab
 	isLoading  bool
 	lastError  error
+
+	// Files tab cursor
+	fileCursor    int
+	collapsedDirs map[string]bool // Directory paths collapsed in the file tree
+
+	// Trackers tab cursor
+	trackerCursor int
+
+	// Peers tab cursor over peerOrder, the peer keys in display order
+	peerCursor int
+	peerOrder  []string
 }
 
 // NewTorrentDetails creates a new torrent details component
 func NewTorrentDetails(client api.ClientInterface) *TorrentDetails {
 	return &TorrentDetails{
-		client:    client,
-		activeTab: TabGeneral,
+		client:        client,
+		activeTab:     TabGeneral,
+		collapsedDirs: make(map[string]bool),
 	}
 }
 
@@ -52,6 +67,12 @@ func (t *TorrentDetails) SetTorrent(torrent *api.Torrent) tea.Cmd {
 	t.torrent = torrent
 	t.scroll = 0
 	t.activeTab = TabGeneral
+	t.fileCursor = 0
+	t.trackerCursor = 0
+	t.peerCursor = 0
+	t.peers = nil
+	t.peersRID = 0
+	t.collapsedDirs = make(map[string]bool)
 	t.isLoading = true
 	t.lastError = nil
 
@@ -68,15 +89,87 @@ func (t *TorrentDetails) UpdateTorrent(torrent *api.Torrent) {
 func (t *TorrentDetails) SetSize(width, height int) {
 	t.width = width
 	t.height = height
+	t.clampView()
 }
 
 // DetailsDataMsg represents detailed torrent data
 type DetailsDataMsg struct {
+	Hash       string
 	Properties *api.TorrentProperties
 	Trackers   []api.Tracker
-	Peers      map[string]api.Peer
+	Peers      *api.SyncTorrentPeersResponse // Changes since PeersBaseRID
 	Files      []api.TorrentFile
 	Err        error
+
+	PeersBaseRID int // Peer sync response ID the fetch started from
+}
+
+// DetailsActionMsg reports the result of an action taken from a details tab
+type DetailsActionMsg struct {
+	Success string
+	Err     error
+}
+
+// RenameRequestMsg asks the parent view to open the rename editor for a
+// file or folder in the Files tab
+type RenameRequestMsg struct {
+	Hash  string
+	Path  string
+	IsDir bool
+}
+
+// TrackerRequestMsg asks the parent view to open the tracker editor for the
+// torrent; URL is empty when adding trackers
+type TrackerRequestMsg struct {
+	Hash string
+	URL  string
+}
+
+// TrackerRemoveRequestMsg asks the parent view to confirm removing a tracker
+// from the torrent
+type TrackerRemoveRequestMsg struct {
+	Hash string
+	URL  string
+}
+
+// PeerBanRequestMsg asks the parent view to confirm banning a peer
+type PeerBanRequestMsg struct {
+	Address string
+	Client  string
+}
+
+// AddPeersRequestMsg asks the parent view to open the add peers dialog for
+// the torrent
+type AddPeersRequestMsg struct {
+	Hash string
+}
+
+// trackerActionKeys are handled by the Trackers tab; adding works even
+// when the torrent has no trackers yet
+var trackerActionKeys = key.NewBinding(key.WithKeys("up", "k", "down", "j", "g", "G", "a", "e", "d"))
+
+// peerActionKeys are handled by the Peers tab; adding works even when no
+// peers are connected
+var peerActionKeys = key.NewBinding(key.WithKeys("up", "k", "down", "j", "g", "G", "a", "b"))
+
+// fileActionKeys are handled by the Files tab while it has files to act on
+var fileActionKeys = key.NewBinding(key.WithKeys("up", "k", "down", "j", "g", "G", "enter", "space", " ", "+", "-", "s", "n", "H", "m", "e"))
+
+// HandlesKey reports whether the active tab uses the key for its own
+// actions, in which case it should take precedence over global shortcuts
+func (t *TorrentDetails) HandlesKey(msg tea.KeyPressMsg) bool {
+	if t.isLoading || t.lastError != nil {
+		return false
+	}
+	switch t.activeTab {
+	case TabTrackers:
+		return key.Matches(msg, trackerActionKeys)
+	case TabPeers:
+		return key.Matches(msg, peerActionKeys)
+	case TabFiles:
+		return len(t.files) > 0 && key.Matches(msg, fileActionKeys)
+	}
+	return false
 }
 
 // fetchDetailedData fetches all detailed data for the torrent
@@ -85,51 +178,57 @@ func (t *TorrentDetails) fetchDetailedData() tea.Cmd {
 		return nil
 	}
 
+	hash := t.torrent.Hash
+	peersRID := t.peersRID
+
 	return func() tea.Msg {
 		ctx := context.Background()
-		hash := t.torrent.Hash
 
 		var properties *api.TorrentProperties
 		var trackers []api.Tracker
-		var peers map[string]api.Peer
+		var peers *api.SyncTorrentPeersResponse
 		var files []api.TorrentFile
 		var err error
 
 		// Fetch properties
 		properties, err = t.client.GetTorrentProperties(ctx, hash)
 		if err != nil {
-			return DetailsDataMsg{Err: fmt.Errorf("failed to get properties: %w", err)}
+			return DetailsDataMsg{Hash: hash, Err: fmt.Errorf("failed to get properties: %w", err)}
 		}
 
 		// Fetch trackers
 		trackers, err = t.client.GetTorrentTrackers(ctx, hash)
 		if err != nil {
-			return DetailsDataMsg{Err: fmt.Errorf("failed to get trackers: %w", err)}
+			return DetailsDataMsg{Hash: hash, Err: fmt.Errorf("failed to get trackers: %w", err)}
 		}
 
-		// Fetch peers
-		peers, err = t.client.GetTorrentPeers(ctx, hash)
+		// Fetch peers changed since the last sync
+		peers, err = t.client.SyncTorrentPeers(ctx, hash, peersRID)
 		if err != nil {
-			return DetailsDataMsg{Err: fmt.Errorf("failed to get peers: %w", err)}
+			return DetailsDataMsg{Hash: hash, Err: fmt.Errorf("failed to get peers: %w", err)}
 		}
 
 		// Fetch files
 		files, err = t.client.GetTorrentFiles(ctx, hash)
 		if err != nil {
-			return DetailsDataMsg{Err: fmt.Errorf("failed to get files: %w", err)}
+			return DetailsDataMsg{Hash: hash, Err: fmt.Errorf("failed to get files: %w", err)}
 		}
 
 		return DetailsDataMsg{
-			Properties: properties,
-			Trackers:   trackers,
-			Peers:      peers,
-			Files:      files,
+			Hash:         hash,
+			Properties:   properties,
+			Trackers:     trackers,
+			Peers:        peers,
+			Files:        files,
+			PeersBaseRID: peersRID,
 		}
 	}
 }
 
 // Update handles messages
 func (t *TorrentDetails) Update(msg tea.Msg) (*TorrentDetails, tea.Cmd) {
+	defer t.clampView()
+
 	switch msg := msg.(type) {
 	case time.Time:
 		// Handle tick messages for periodic refresh
@@ -138,21 +237,53 @@ func (t *TorrentDetails) Update(msg tea.Msg) (*TorrentDetails, tea.Cmd) {
 		}
 
 	case DetailsDataMsg:
+		// Drop data for a torrent that is no longer displayed
+		if t.torrent == nil || msg.Hash != t.torrent.Hash {
+			return t, nil
+		}
 		t.isLoading = false
 		if msg.Err != nil {
 			t.lastError = msg.Err
+			t.peersRID = 0 // Resync every peer once the server is reachable again
 		} else {
 			t.properties = msg.Properties
-			t.trackers = msg.Trackers
-			t.peers = msg.Peers
+			t.setTrackers(msg.Trackers)
+			// Overlapping fetches (a tick and a tab switch) can both start
+			// from the same peer sync; only the first delta still applies
+			if msg.PeersBaseRID == t.peersRID {
+				t.peers = api.ApplyPeerSync(t.peers, msg.Peers)
+				t.peersRID = msg.Peers.RID
+			}
 			t.files = msg.Files
 			t.lastError = nil
 
-			// Sort trackers for stable display order
-			t.sortTrackers()
+			// Sort peers for stable display order
+			t.sortPeers()
+		}
+
+	case DetailsActionMsg:
+		// Reload so the tabs reflect the change
+		if msg.Err == nil && t.torrent != nil {
+			return t, t.fetchDetailedData()
 		}
 
 	case tea.KeyPressMsg:
+		if t.activeTab == TabTrackers {
+			if handled, cmd := t.handleTrackersKey(msg); handled {
+				return t, cmd
+			}
+		}
+		if t.activeTab == TabPeers {
+			if handled, cmd := t.handlePeersKey(msg); handled {
+				return t, cmd
+			}
+		}
+		if t.activeTab == TabFiles && len(t.files) > 0 {
+			if handled, cmd := t.handleFilesKey(msg); handled {
+				return t, cmd
+			}
+		}
+
 		switch {
 		// Tab navigation
 		case key.Matches(msg, key.NewBinding(key.WithKeys("1"))):
@@ -203,7 +334,7 @@ func (t *TorrentDetails) Update(msg tea.Msg) (*TorrentDetails, tea.Cmd) {
 
 // View renders the torrent details with tabs
 func (t *TorrentDetails) View() string {
-	fullContent := t.buildContent()
+	fullContent, _ := t.buildContent()
 
 	// Apply scrolling
 	lines := strings.Split(fullContent, "\n")
@@ -213,29 +344,48 @@ func (t *TorrentDetails) View() string {
 			visibleLines = 1
 		}
 
-		maxScroll := len(lines) - visibleLines
-		if maxScroll < 0 {
-			maxScroll = 0
-		}
-		if t.scroll > maxScroll {
-			t.scroll = maxScroll
-		}
-
 		if len(lines) > visibleLines {
-			endLine := t.scroll + visibleLines
-			if endLine > len(lines) {
-				endLine = len(lines)
-			}
-			lines = lines[t.scroll:endLine]
+			startLine := min(t.scroll, len(lines)-visibleLines)
+			lines = lines[startLine : startLine+visibleLines]
 		}
 	}
 
 	return strings.Join(lines, "\n")
 }
 
-func (t *TorrentDetails) buildContent() string {
+// clampView keeps the tab cursors within their rows and the scroll offset
+// within the content, scrolling so the Files, Trackers or Peers cursor stays
+// on screen. It runs after every update so View never has to adjust state.
+func (t *TorrentDetails) clampView() {
+	t.trackerCursor = max(min(t.trackerCursor, len(t.trackers)-1), 0)
+	t.peerCursor = max(min(t.peerCursor, len(t.peerOrder)-1), 0)
+	t.fileCursor = max(min(t.fileCursor, len(buildFileRows(t.files, t.collapsedDirs))-1), 0)
+
+	if t.height <= 0 {
+		return
+	}
+	visibleLines := t.height - 2 // Account for borders
+	if visibleLines < 1 {
+		visibleLines = 1
+	}
+
+	content, cursorLine := t.buildContent()
+	if cursorLine >= 0 {
+		if cursorLine < t.scroll {
+			t.scroll = cursorLine
+		} else if cursorLine >= t.scroll+visibleLines {
+			t.scroll = cursorLine - visibleLines + 1
+		}
+	}
+	maxScroll := max(len(strings.Split(content, "\n"))-visibleLines, 0)
+	t.scroll = max(min(t.scroll, maxScroll), 0)
+}
+
+// buildContent renders the full details content and returns it with the
+// line of the active tab's cursor, or -1 when no cursor is shown
+func (t *TorrentDetails) buildContent() (string, int) {
 	if t.torrent == nil {
-		return styles.DimStyle.Render("No torrent selected")
+		return styles.DimStyle.Render("No torrent selected"), -1
 	}
 
 	var sections []string
@@ -250,20 +400,27 @@ func (t *TorrentDetails) buildContent() string {
 
 	// Content based on active tab
 	var content string
+	contentCursor := -1
 	if t.isLoading {
 		content = styles.DimStyle.Render("Loading detailed information...")
 	} else if t.lastError != nil {
 		content = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", t.lastError))
 	} else {
+		// Offset a tab's cursor by the title and tab bar sections above it
+		offset := len(strings.Split(strings.Join(sections, "\n\n"), "\n")) + 1
+		cursorLine := -1
 		switch t.activeTab {
 		case TabGeneral:
 			content = t.renderGeneralTab()
 		case TabTrackers:
-			content = t.renderTrackersTab()
+			content, cursorLine = t.renderTrackersTab()
 		case TabPeers:
-			content = t.renderPeersTab()
+			content, cursorLine = t.renderPeersTab()
 		case TabFiles:
-			content = t.renderFilesTab()
+			content, cursorLine = t.renderFilesTab()
+		}
+		if cursorLine >= 0 {
+			contentCursor = offset + cursorLine
 		}
 	}
 
@@ -271,9 +428,18 @@ func (t *TorrentDetails) buildContent() string {
 
 	// Help text
 	help := styles.DimStyle.Render("↑↓ scroll • ←→ or 1-4 tabs • Tab cycle • Esc back")
+	if t.activeTab == TabTrackers {
+		help = styles.DimStyle.Render("↑↓ select • a add • e edit • d remove • ←→ or 1-4 tabs • Esc back")
+	}
+	if t.activeTab == TabPeers {
+		help = styles.DimStyle.Render("↑↓ select • a add peers • b ban peer • ←→ or 1-4 tabs • Esc back")
+	}
+	if t.activeTab == TabFiles && len(t.files) > 0 {
+		help = styles.DimStyle.Render("↑↓ select • Enter fold • +/- all • s/n/H/m priority • e rename • Esc back")
+	}
 	sections = append(sections, help)
 
-	return strings.Join(sections, "\n\n")
+	return strings.Join(sections, "\n\n"), contentCursor
 }
 
 // renderTabBar renders the tab navigation bar
@@ -315,16 +481,64 @@ func (t *TorrentDetails) renderGeneralTab() string {
 	return strings.Join(sections, "\n\n")
 }
 
-// renderTrackersTab renders the trackers information
-func (t *TorrentDetails) renderTrackersTab() string {
-	if len(t.trackers) == 0 {
-		return styles.DimStyle.Render("No trackers found")
+// handleTrackersKey handles cursor movement and tracker actions in the Trackers tab
+func (t *TorrentDetails) handleTrackersKey(msg tea.KeyPressMsg) (bool, tea.Cmd) {
+	if t.torrent == nil {
+		return false, nil
+	}
+	if t.trackerCursor >= len(t.trackers) {
+		t.trackerCursor = max(len(t.trackers)-1, 0)
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if t.trackerCursor > 0 {
+			t.trackerCursor--
+		}
+	case "down", "j":
+		if t.trackerCursor < len(t.trackers)-1 {
+			t.trackerCursor++
+		}
+	case "g":
+		t.trackerCursor = 0
+	case "G":
+		t.trackerCursor = max(len(t.trackers)-1, 0)
+	case "a":
+		req := TrackerRequestMsg{Hash: t.torrent.Hash}
+		return true, func() tea.Msg { return req }
+	case "e", "d":
+		if len(t.trackers) == 0 {
+			return true, nil
+		}
+		tracker := t.trackers[t.trackerCursor]
+		if tracker.IsPseudoTracker() {
+			return true, func() tea.Msg {
+				return DetailsActionMsg{Err: fmt.Errorf("DHT, PeX and LSD entries cannot be edited")}
+			}
+		}
+		if msg.String() == "e" {
+			req := TrackerRequestMsg{Hash: t.torrent.Hash, URL: tracker.URL}
+			return true, func() tea.Msg { return req }
+		}
+		req := TrackerRemoveRequestMsg{Hash: t.torrent.Hash, URL: tracker.URL}
+		return true, func() tea.Msg { return req }
+	default:
+		return false, nil
 	}
+	return true, nil
+}
 
+// renderTrackersTab renders the trackers information and returns the line of
+// the cursor row within the rendered tab
+func (t *TorrentDetails) renderTrackersTab() (string, int) {
+	if len(t.trackers) == 0 {
+		return styles.DimStyle.Render("No trackers found"), -1
+	}
 	var lines []string
 	lines = append(lines, styles.SubtitleStyle.Render("Trackers"))
 	lines = append(lines, "")
 
+	cursorLine := -1
 	for i, tracker := range t.trackers {
 		status := t.getTrackerStatus(tracker.Status)
 		statusStyle := styles.DimStyle
@@ -335,7 +549,12 @@ func (t *TorrentDetails) renderTrackersTab() string {
 		}
 
 		line := fmt.Sprintf("%d. %s", i+1, tracker.URL)
-		lines = append(lines, styles.TextStyle.Render(line))
+		if i == t.trackerCursor {
+			cursorLine = len(lines)
+			lines = append(lines, styles.SelectedRowStyle.Render(line))
+		} else {
+			lines = append(lines, styles.TextStyle.Render(line))
+		}
 
 		details := fmt.Sprintf("   Status: %s | Tier: %d | Peers: %d | Seeds: %d | Leeches: %d",
 			statusStyle.Render(status), tracker.Tier, tracker.NumPeers, tracker.NumSeeds, tracker.NumLeeches)
@@ -348,15 +567,59 @@ func (t *TorrentDetails) renderTrackersTab() string {
 		lines = append(lines, "")
 	}
 
-	return strings.Join(lines, "\n")
+	return strings.Join(lines, "\n"), cursorLine
 }
 
-// renderPeersTab renders the peers information
-func (t *TorrentDetails) renderPeersTab() string {
-	if len(t.peers) == 0 {
-		return styles.DimStyle.Render("No peers connected")
+// handlePeersKey handles cursor movement and peer actions in the Peers tab
+func (t *TorrentDetails) handlePeersKey(msg tea.KeyPressMsg) (bool, tea.Cmd) {
+	if t.torrent == nil {
+		return false, nil
+	}
+	if t.peerCursor >= len(t.peerOrder) {
+		t.peerCursor = max(len(t.peerOrder)-1, 0)
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if t.peerCursor > 0 {
+			t.peerCursor--
+		}
+	case "down", "j":
+		if t.peerCursor < len(t.peerOrder)-1 {
+			t.peerCursor++
+		}
+	case "g":
+		t.peerCursor = 0
+	case "G":
+		t.peerCursor = max(len(t.peerOrder)-1, 0)
+	case "a":
+		req := AddPeersRequestMsg{Hash: t.torrent.Hash}
+		return true, func() tea.Msg { return req }
+	case "b":
+		if len(t.peerOrder) == 0 {
+			return true, nil
+		}
+		peer := t.peers[t.peerOrder[t.peerCursor]]
+		req := PeerBanRequestMsg{Address: peerAddress(peer), Client: peer.Client}
+		return true, func() tea.Msg { return req }
+	default:
+		return false, nil
 	}
+	return true, nil
+}
+
+// peerAddress formats a peer as the "ip:port" address the API expects,
+// bracketing IPv6 addresses
+func peerAddress(peer api.Peer) string {
+	return net.JoinHostPort(peer.IP, strconv.Itoa(peer.Port))
+}
 
+// renderPeersTab renders the peers information and returns the line of the
+// cursor row within the rendered tab
+func (t *TorrentDetails) renderPeersTab() (string, int) {
+	if len(t.peerOrder) == 0 {
+		return styles.DimStyle.Render("No peers connected"), -1
+	}
 	var lines []string
 	lines = append(lines, styles.SubtitleStyle.Render(fmt.Sprintf("Peers (%d connected)", len(t.peers))))
 	lines = append(lines, "")
@@ -411,13 +674,15 @@ func (t *TorrentDetails) renderPeersTab() string {
 	lines = append(lines, styles.DimStyle.Render(strings.Repeat("─", availableWidth)))
 
 	// Build data rows
-	for _, peer := range t.peers {
+	cursorLine := -1
+	for i, id := range t.peerOrder {
+		peer := t.peers[id]
 		progress := fmt.Sprintf("%.1f%%", peer.Progress*100)
 		dlSpeed := formatBytes(peer.DlSpeed) + "/s"
 		ulSpeed := formatBytes(peer.UpSpeed) + "/s"
 		downloaded := formatBytes(peer.Downloaded)
 		uploaded := formatBytes(peer.Uploaded)
-		address := fmt.Sprintf("%s:%d", peer.IP, peer.Port)
+		address := peerAddress(peer)
 
 		values := []string{
 			address,
@@ -432,13 +697,18 @@ func (t *TorrentDetails) renderPeersTab() string {
 		}
 
 		var rowParts []string
-		for i, value := range values {
-			rowParts = append(rowParts, t.padString(value, widths[i]))
+		for col, value := range values {
+			rowParts = append(rowParts, t.padString(value, widths[col]))
+		}
+		row := strings.Join(rowParts, "")
+		if i == t.peerCursor {
+			cursorLine = len(lines)
+			row = styles.SelectedRowStyle.Render(row)
 		}
-		lines = append(lines, strings.Join(rowParts, ""))
+		lines = append(lines, row)
 	}
 
-	return strings.Join(lines, "\n")
+	return strings.Join(lines, "\n"), cursorLine
 }
 
 // padString pads or truncates a string to the specified width
@@ -458,12 +728,216 @@ func (t *TorrentDetails) padString(s string, width int) string {
 	return s + strings.Repeat(" ", width-runeLen)
 }
 
-// renderFilesTab renders the files information
-func (t *TorrentDetails) renderFilesTab() string {
+// fileNode is a directory or file in the torrent's file tree
+type fileNode struct {
+	name     string
+	path     string
+	isDir    bool
+	children []*fileNode
+	file     api.TorrentFile
+}
+
+// fileRow is a visible line in the Files tab. Directory rows aggregate the
+// size, progress and priority of every file below them.
+type fileRow struct {
+	name     string
+	path     string
+	depth    int
+	isDir    bool
+	expanded bool
+	fileIDs  []int // Indexes of all files covered by the row
+	size     int64
+	progress float64
+	priority int // -1 when the files in a directory have mixed priorities
+}
+
+// buildFileTree builds a directory tree from the '/' separated file names.
+// Directories are listed before files, each sorted by name.
+func buildFileTree(files []api.TorrentFile) *fileNode {
+	root := &fileNode{isDir: true}
+	dirs := map[string]*fileNode{"": root}
+
+	for _, file := range files {
+		parent := root
+		parts := strings.Split(file.Name, "/")
+		for i, part := range parts[:len(parts)-1] {
+			dirPath := strings.Join(parts[:i+1], "/")
+			dir, exists := dirs[dirPath]
+			if !exists {
+				dir = &fileNode{name: part, path: dirPath, isDir: true}
+				dirs[dirPath] = dir
+				parent.children = append(parent.children, dir)
+			}
+			parent = dir
+		}
+		parent.children = append(parent.children, &fileNode{
+			name: parts[len(parts)-1],
+			path: file.Name,
+			file: file,
+		})
+	}
+
+	for _, dir := range dirs {
+		sort.SliceStable(dir.children, func(i, j int) bool {
+			a, b := dir.children[i], dir.children[j]
+			if a.isDir != b.isDir {
+				return a.isDir
+			}
+			return strings.ToLower(a.name) < strings.ToLower(b.name)
+		})
+	}
+	return root
+}
+
+// buildFileRows flattens the file tree into visible rows, skipping the
+// contents of collapsed directories
+func buildFileRows(files []api.TorrentFile, collapsed map[string]bool) []fileRow {
+	var rows []fileRow
+	var walk func(node *fileNode, depth int)
+	walk = func(node *fileNode, depth int) {
+		for _, child := range node.children {
+			row := newFileRow(child, depth)
+			row.expanded = child.isDir && !collapsed[child.path]
+			rows = append(rows, row)
+			if row.expanded {
+				walk(child, depth+1)
+			}
+		}
+	}
+	walk(buildFileTree(files), 0)
+	return rows
+}
+
+// newFileRow creates a row for a node, aggregating over directory contents
+func newFileRow(node *fileNode, depth int) fileRow {
+	row := fileRow{name: node.name, path: node.path, depth: depth, isDir: node.isDir, priority: -1}
+
+	var done float64
+	first := true
+	var collect func(n *fileNode)
+	collect = func(n *fileNode) {
+		if !n.isDir {
+			row.fileIDs = append(row.fileIDs, n.file.Index)
+			row.size += n.file.Size
+			done += float64(n.file.Size) * n.file.Progress
+			if first {
+				row.priority = n.file.Priority
+				first = false
+			} else if n.file.Priority != row.priority {
+				row.priority = -1
+			}
+			return
+		}
+		for _, child := range n.children {
+			collect(child)
+		}
+	}
+	collect(node)
+
+	if !node.isDir {
+		row.progress = node.file.Progress
+	} else if row.size > 0 {
+		row.progress = done / float64(row.size)
+	}
+	return row
+}
+
+// setAllCollapsed collapses or expands every directory in the file tree
+func (t *TorrentDetails) setAllCollapsed(collapse bool) {
+	t.collapsedDirs = make(map[string]bool)
+	if !collapse {
+		return
+	}
+	for _, row := range buildFileRows(t.files, nil) {
+		if row.isDir {
+			t.collapsedDirs[row.path] = true
+		}
+	}
+}
+
+// handleFilesKey handles cursor movement and priority keys in the Files tab
+func (t *TorrentDetails) handleFilesKey(msg tea.KeyPressMsg) (bool, tea.Cmd) {
+	rows := buildFileRows(t.files, t.collapsedDirs)
+	if t.fileCursor >= len(rows) {
+		t.fileCursor = len(rows) - 1
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if t.fileCursor > 0 {
+			t.fileCursor--
+		}
+	case "down", "j":
+		if t.fileCursor < len(rows)-1 {
+			t.fileCursor++
+		}
+	case "g":
+		t.fileCursor = 0
+	case "G":
+		t.fileCursor = len(rows) - 1
+	case "enter", "space", " ":
+		if row := rows[t.fileCursor]; row.isDir {
+			t.collapsedDirs[row.path] = row.expanded
+		}
+	case "+":
+		t.setAllCollapsed(false)
+	case "-":
+		// Keep the cursor on the top-level directory it was inside
+		t.setAllCollapsed(true)
+		top := strings.SplitN(rows[t.fileCursor].path, "/", 2)[0]
+		t.fileCursor = 0
+		for i, row := range buildFileRows(t.files, t.collapsedDirs) {
+			if row.path == top {
+				t.fileCursor = i
+				break
+			}
+		}
+	case "s":
+		return true, t.setFilePriority(rows[t.fileCursor], api.FilePrioritySkip)
+	case "n":
+		return true, t.setFilePriority(rows[t.fileCursor], api.FilePriorityNormal)
+	case "H":
+		return true, t.setFilePriority(rows[t.fileCursor], api.FilePriorityHigh)
+	case "m":
+		return true, t.setFilePriority(rows[t.fileCursor], api.FilePriorityMaximum)
+	case "e":
+		if t.torrent == nil {
+			return true, nil
+		}
+		req := RenameRequestMsg{Hash: t.torrent.Hash, Path: rows[t.fileCursor].path, IsDir: rows[t.fileCursor].isDir}
+		return true, func() tea.Msg { return req }
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// setFilePriority sets the priority of every file covered by the row
+func (t *TorrentDetails) setFilePriority(row fileRow, priority int) tea.Cmd {
+	if t.torrent == nil {
+		return nil
+	}
+	hash := t.torrent.Hash
+	name := row.name
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := t.client.SetFilePriority(ctx, hash, row.fileIDs, priority); err != nil {
+			return DetailsActionMsg{Err: fmt.Errorf("failed to set file priority: %w", err)}
+		}
+		return DetailsActionMsg{Success: fmt.Sprintf("%s priority: %s", name, t.getFilePriority(priority))}
+	}
+}
+
+// renderFilesTab renders the files information and returns the line of the
+// cursor row within the rendered tab
+func (t *TorrentDetails) renderFilesTab() (string, int) {
 	if len(t.files) == 0 {
-		return styles.DimStyle.Render("No files found")
+		return styles.DimStyle.Render("No files found"), -1
 	}
 
+	rows := buildFileRows(t.files, t.collapsedDirs)
+
 	var lines []string
 	lines = append(lines, styles.SubtitleStyle.Render(fmt.Sprintf("Files (%d total)", len(t.files))))
 	lines = append(lines, "")
@@ -513,13 +987,28 @@ func (t *TorrentDetails) renderFilesTab() string {
 	lines = append(lines, styles.DimStyle.Render(strings.Repeat("─", availableWidth)))
 
 	// Build data rows
-	for _, file := range t.files {
-		size := formatBytes(file.Size)
-		progress := fmt.Sprintf("%.1f%%", file.Progress*100)
-		priority := t.getFilePriority(file.Priority)
+	cursorLine := -1
+	for i, row := range rows {
+		size := formatBytes(row.size)
+		progress := fmt.Sprintf("%.1f%%", row.progress*100)
+		priority := "Mixed"
+		if row.priority >= 0 {
+			priority = t.getFilePriority(row.priority)
+		}
+
+		// Indent by depth, with an expand/collapse marker on directories
+		name := strings.Repeat("  ", row.depth)
+		switch {
+		case row.isDir && row.expanded:
+			name += "▾ " + row.name + "/"
+		case row.isDir:
+			name += "▸ " + row.name + "/"
+		default:
+			name += "  " + row.name
+		}
 
 		values := []string{
-			file.Name,
+			name,
 			size,
 			progress,
 			priority,
@@ -532,10 +1021,17 @@ func (t *TorrentDetails) renderFilesTab() string {
 
 		line := strings.Join(rowParts, "")
 
-		// Style based on progress
-		if file.Progress >= 1.0 {
+		// Style based on cursor, priority and progress
+		if i == t.fileCursor {
+			line = styles.SelectedRowStyle.Render(line)
+			cursorLine = len(lines)
+		} else if row.isDir {
+			line = styles.SubtitleStyle.Render(line)
+		} else if row.priority == api.FilePrioritySkip {
+			line = styles.DimStyle.Render(line)
+		} else if row.progress >= 1.0 {
 			line = styles.AccentStyle.Render(line)
-		} else if file.Progress > 0 {
+		} else if row.progress > 0 {
 			line = styles.TextStyle.Render(line)
 		} else {
 			line = styles.DimStyle.Render(line)
@@ -544,7 +1040,7 @@ func (t *TorrentDetails) renderFilesTab() string {
 		lines = append(lines, line)
 	}
 
-	return strings.Join(lines, "\n")
+	return strings.Join(lines, "\n"), cursorLine
 }
 
 // Helper methods (keeping existing ones and adding new ones)
@@ -559,7 +1055,8 @@ func (t *TorrentDetails) getMaxScroll() int {
 		visibleLines = 1
 	}
 
-	lines := strings.Split(t.buildContent(), "\n")
+	content, _ := t.buildContent()
+	lines := strings.Split(content, "\n")
 	maxScroll := len(lines) - visibleLines
 	if maxScroll < 0 {
 		return 0
@@ -642,6 +1139,16 @@ func (t *TorrentDetails) renderTransferInfo() string {
 	lines = append(lines, fmt.Sprintf("Downloaded: %s", formatBytes(t.torrent.Downloaded)))
 	lines = append(lines, fmt.Sprintf("Uploaded: %s", formatBytes(t.torrent.Uploaded)))
 	lines = append(lines, fmt.Sprintf("Ratio: %.3f", t.torrent.Ratio))
+	lines = append(lines, fmt.Sprintf("Download Limit: %s", styles.FormatSpeedLimit(t.torrent.DlLimit)))
+	lines = append(lines, fmt.Sprintf("Upload Limit: %s", styles.FormatSpeedLimit(t.torrent.UpLimit)))
+	lines = append(lines, fmt.Sprintf("Ratio Limit: %s", formatShareLimit(
+		formatRatioLimit(t.torrent.MaxRatio), t.torrent.RatioLimit == api.ShareLimitGlobal)))
+	lines = append(lines, fmt.Sprintf("Seeding Time Limit: %s", formatShareLimit(
+		styles.FormatSeedingTime(t.torrent.MaxSeedingTime), t.torrent.SeedingTimeLimit == api.ShareLimitGlobal)))
+	if t.torrent.InactiveSeedingTimeLimit != 0 { // Only reported by qBittorrent 4.6+
+		lines = append(lines, fmt.Sprintf("Inactive Seeding Limit: %s", formatShareLimit(
+			styles.FormatSeedingTime(t.torrent.MaxInactiveSeedingTime), t.torrent.InactiveSeedingTimeLimit == api.ShareLimitGlobal)))
+	}
 
 	if t.torrent.ETA > 0 && t.torrent.ETA < 8640000 { // Less than 100 days
 		etaDuration := time.Duration(t.torrent.ETA) * time.Second
@@ -675,20 +1182,14 @@ func (t *TorrentDetails) renderTechnicalInfo() string {
 	lines = append(lines, styles.SubtitleStyle.Render("Technical Information"))
 	lines = append(lines, fmt.Sprintf("Priority: %d", t.torrent.Priority))
 	lines = append(lines, fmt.Sprintf("Auto TMM: %t", t.torrent.AutoTMM))
+	lines = append(lines, fmt.Sprintf("Sequential Download: %t", t.torrent.SeqDownload))
+	lines = append(lines, fmt.Sprintf("First/Last Pieces First: %t", t.torrent.FirstLastPiece))
 
 	if t.torrent.TimeActive > 0 {
 		activeDuration := time.Duration(t.torrent.TimeActive) * time.Second
 		lines = append(lines, fmt.Sprintf("Time Active: %s", activeDuration.String()))
 	}
 
-	if t.torrent.MaxRatio >= 0 {
-		if t.torrent.MaxRatio == -1 {
-			lines = append(lines, "Max Ratio: ∞")
-		} else {
-			lines = append(lines, fmt.Sprintf("Max Ratio: %.3f", t.torrent.MaxRatio))
-		}
-	}
-
 	return strings.Join(lines, "\n")
 }
 
@@ -735,6 +1236,63 @@ func (t *TorrentDetails) sortTrackers() {
 	})
 }
 
+// setTrackers replaces the trackers in sorted order, keeping the cursor on
+// the same tracker when trackers are added or removed between refreshes
+func (t *TorrentDetails) setTrackers(trackers []api.Tracker) {
+	var selected string
+	if t.trackerCursor < len(t.trackers) {
+		selected = t.trackers[t.trackerCursor].URL
+	}
+
+	t.trackers = trackers
+	t.sortTrackers()
+
+	for i, tracker := range t.trackers {
+		if tracker.URL == selected {
+			t.trackerCursor = i
+			break
+		}
+	}
+}
+
+// sortPeers orders the peers by address, keeping the cursor on the same peer
+// when peers connect or disconnect between refreshes
+func (t *TorrentDetails) sortPeers() {
+	var selected string
+	if t.peerCursor < len(t.peerOrder) {
+		selected = t.peerOrder[t.peerCursor]
+	}
+
+	t.peerOrder = t.peerOrder[:0]
+	for id := range t.peers {
+		t.peerOrder = append(t.peerOrder, id)
+	}
+	sort.Strings(t.peerOrder)
+
+	for i, id := range t.peerOrder {
+		if id == selected {
+			t.peerCursor = i
+			break
+		}
+	}
+}
+
+// formatRatioLimit formats an effective ratio limit, showing ∞ when unlimited
+func formatRatioLimit(ratio float64) string {
+	if ratio < 0 {
+		return "∞"
+	}
+	return fmt.Sprintf("%.2f", ratio)
+}
+
+// formatShareLimit marks an effective share limit that follows the global setting
+func formatShareLimit(value string, global bool) string {
+	if global {
+		return value + styles.DimStyle.Render(" (global)")
+	}
+	return value
+}
+
 // formatBytes formats a byte count as human readable string
 func formatBytes(bytes int64) string {
 	const unit = 1024
@@ -0,0 +1,323 @@
+package components
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestBuildFileRows(t *testing.T) {
+	files := []api.TorrentFile{
+		{Index: 0, Name: "Show/S01/E02.mkv", Size: 300, Progress: 0, Priority: 0},
+		{Index: 1, Name: "Show/S01/E01.mkv", Size: 100, Progress: 1, Priority: 1},
+		{Index: 2, Name: "Show/info.nfo", Size: 10, Progress: 1, Priority: 1},
+		{Index: 3, Name: "Show/S02/E01.mkv", Size: 50, Progress: 0, Priority: 1},
+	}
+
+	rows := buildFileRows(files, nil)
+	var paths []string
+	for _, row := range rows {
+		paths = append(paths, row.path)
+	}
+	want := []string{"Show", "Show/S01", "Show/S01/E01.mkv", "Show/S01/E02.mkv", "Show/S02", "Show/S02/E01.mkv", "Show/info.nfo"}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Fatalf("rows: got %v, want %v (directories first, sorted by name)", paths, want)
+	}
+
+	season := rows[1]
+	if !season.isDir || season.depth != 1 || !season.expanded {
+		t.Errorf("S01 should be an expanded directory at depth 1, got %+v", season)
+	}
+	if season.size != 400 || season.progress != 0.25 {
+		t.Errorf("directory aggregate: got size %d progress %.2f, want 400 and 0.25", season.size, season.progress)
+	}
+	if season.priority != -1 {
+		t.Errorf("directory with skipped and normal files should be mixed, got %d", season.priority)
+	}
+	if len(rows[0].fileIDs) != 4 {
+		t.Errorf("root directory should cover all files, got %v", rows[0].fileIDs)
+	}
+	if rows[4].priority != 1 {
+		t.Errorf("S02 should have normal priority, got %d", rows[4].priority)
+	}
+
+	// Collapsing a directory hides everything below it
+	rows = buildFileRows(files, map[string]bool{"Show/S01": true})
+	if len(rows) != 5 || rows[1].expanded || rows[2].path != "Show/S02" {
+		t.Errorf("collapsed S01 should hide its files, got %d rows", len(rows))
+	}
+}
+
+func TestFilesTabFolding(t *testing.T) {
+	details := NewTorrentDetails(api.NewMockClient())
+	details.torrent = &api.Torrent{Hash: "hash1"}
+	details.activeTab = TabFiles
+	details.files = []api.TorrentFile{
+		{Index: 0, Name: "Pack/A/1.mkv"},
+		{Index: 1, Name: "Pack/B/1.mkv"},
+	}
+
+	enter := tea.KeyPressMsg{Code: tea.KeyEnter}
+	details.Update(enter)
+	if rows := buildFileRows(details.files, details.collapsedDirs); len(rows) != 1 {
+		t.Errorf("enter on Pack should collapse it, got %d rows", len(rows))
+	}
+	details.Update(enter)
+	if rows := buildFileRows(details.files, details.collapsedDirs); len(rows) != 5 {
+		t.Errorf("enter again should expand Pack, got %d rows", len(rows))
+	}
+
+	// Collapse all from inside B keeps the cursor on the top-level directory
+	details.fileCursor = 4
+	details.Update(tea.KeyPressMsg{Code: '-', Text: "-"})
+	if rows := buildFileRows(details.files, details.collapsedDirs); len(rows) != 1 || details.fileCursor != 0 {
+		t.Errorf("collapse all: got %d rows, cursor %d", len(rows), details.fileCursor)
+	}
+	details.Update(tea.KeyPressMsg{Code: '+', Text: "+"})
+	if rows := buildFileRows(details.files, details.collapsedDirs); len(rows) != 5 {
+		t.Errorf("expand all: got %d rows, want 5", len(rows))
+	}
+}
+
+func TestFilesTabScrollFollowsCursor(t *testing.T) {
+	details := NewTorrentDetails(api.NewMockClient())
+	details.torrent = &api.Torrent{Hash: "hash1", Name: "many"}
+	details.activeTab = TabFiles
+	for i := range 40 {
+		details.files = append(details.files, api.TorrentFile{Index: i, Name: fmt.Sprintf("file%02d.mkv", i)})
+	}
+	details.SetSize(100, 20)
+
+	details.Update(tea.KeyPressMsg{Code: 'G', Text: "G"})
+	scroll := details.scroll
+	if scroll == 0 {
+		t.Fatal("moving to the last file should scroll down")
+	}
+	view := details.View()
+	if !strings.Contains(view, "file39.mkv") {
+		t.Error("the cursor row should be on screen")
+	}
+	if details.View() != view || details.scroll != scroll {
+		t.Error("rendering should not change the scroll offset")
+	}
+
+	// Growing the pane clamps the scroll to the new content
+	details.SetSize(100, 200)
+	if details.scroll != 0 {
+		t.Errorf("everything fits, scroll should reset, got %d", details.scroll)
+	}
+}
+
+func TestFilesTabPriorityKeys(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Files["hash1"] = []api.TorrentFile{
+		{Index: 0, Name: "Season/E01.mkv", Priority: api.FilePriorityNormal},
+		{Index: 1, Name: "Season/E02.mkv", Priority: api.FilePriorityNormal},
+	}
+
+	details := NewTorrentDetails(mock)
+	details.torrent = &api.Torrent{Hash: "hash1"}
+	details.files, _ = mock.GetTorrentFiles(context.Background(), "hash1")
+	details.activeTab = TabFiles
+
+	skip := tea.KeyPressMsg{Code: 's', Text: "s"}
+	if !details.HandlesKey(skip) {
+		t.Fatal("Files tab should handle priority keys")
+	}
+
+	// Cursor starts on the directory row, so the whole directory is skipped
+	_, cmd := details.Update(skip)
+	if cmd == nil {
+		t.Fatal("expected a command to set the priority")
+	}
+	if msg, ok := cmd().(DetailsActionMsg); !ok || msg.Err != nil {
+		t.Fatalf("unexpected result: %#v", msg)
+	}
+	for _, file := range mock.Files["hash1"] {
+		if file.Priority != api.FilePrioritySkip {
+			t.Errorf("%s: got priority %d, want skip", file.Name, file.Priority)
+		}
+	}
+
+	details.activeTab = TabGeneral
+	if details.HandlesKey(skip) {
+		t.Error("other tabs should not claim priority keys")
+	}
+}
+
+func TestTrackersTabActions(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Trackers["hash1"] = []api.Tracker{
+		{URL: "** [DHT] **", Tier: -1},
+		{URL: "https://a.example/announce", Tier: 0},
+		{URL: "https://b.example/announce", Tier: 1},
+	}
+
+	details := NewTorrentDetails(mock)
+	details.torrent = &api.Torrent{Hash: "hash1"}
+	details.trackers, _ = mock.GetTorrentTrackers(context.Background(), "hash1")
+	details.sortTrackers()
+	details.activeTab = TabTrackers
+
+	remove := tea.KeyPressMsg{Code: 'd', Text: "d"}
+	if !details.HandlesKey(remove) {
+		t.Fatal("Trackers tab should take precedence for d")
+	}
+
+	// Pseudo trackers can't be removed
+	_, cmd := details.Update(remove)
+	if msg, ok := cmd().(DetailsActionMsg); !ok || msg.Err == nil {
+		t.Errorf("removing DHT should fail, got %#v", msg)
+	}
+
+	details.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	_, cmd = details.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if req, ok := cmd().(TrackerRequestMsg); !ok || req.URL != "https://a.example/announce" {
+		t.Errorf("edit should request the tracker under the cursor, got %#v", req)
+	}
+
+	// The cursor follows its tracker when others are added on refresh
+	details.setTrackers([]api.Tracker{
+		{URL: "https://b.example/announce", Tier: 1},
+		{URL: "https://a.example/announce", Tier: 0},
+		{URL: "https://0.example/announce", Tier: 0},
+		{URL: "** [DHT] **", Tier: -1},
+	})
+	if url := details.trackers[details.trackerCursor].URL; url != "https://a.example/announce" {
+		t.Errorf("cursor moved to %s", url)
+	}
+	details.setTrackers(mock.Trackers["hash1"])
+
+	_, cmd = details.Update(remove)
+	if req, ok := cmd().(TrackerRemoveRequestMsg); !ok || req.Hash != "hash1" || req.URL != "https://a.example/announce" {
+		t.Errorf("remove should request confirmation for the tracker under the cursor, got %#v", req)
+	}
+	if len(mock.Trackers["hash1"]) != 3 {
+		t.Errorf("tracker should not be removed before confirmation, got %v", mock.Trackers["hash1"])
+	}
+
+	_, cmd = details.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if req, ok := cmd().(TrackerRequestMsg); !ok || req.URL != "" {
+		t.Errorf("add should request an empty tracker editor, got %#v", req)
+	}
+}
+
+func TestRenderTransferInfoShareLimits(t *testing.T) {
+	details := NewTorrentDetails(api.NewMockClient())
+	details.torrent = &api.Torrent{
+		Hash:                     "hash1",
+		RatioLimit:               api.ShareLimitGlobal,
+		MaxRatio:                 1.5,
+		SeedingTimeLimit:         720,
+		MaxSeedingTime:           720,
+		InactiveSeedingTimeLimit: api.ShareLimitUnlimited,
+		MaxInactiveSeedingTime:   api.ShareLimitUnlimited,
+	}
+
+	info := details.renderTransferInfo()
+	for _, want := range []string{"Ratio Limit: 1.50", "(global)", "Seeding Time Limit: 12h", "Inactive Seeding Limit: ∞"} {
+		if !strings.Contains(info, want) {
+			t.Errorf("transfer info should contain %q, got:\n%s", want, info)
+		}
+	}
+
+	// Servers older than 4.6 don't report the inactive limit
+	details.torrent.InactiveSeedingTimeLimit = 0
+	if strings.Contains(details.renderTransferInfo(), "Inactive") {
+		t.Error("inactive limit should be hidden when not reported")
+	}
+}
+
+func TestPeersTabActions(t *testing.T) {
+	details := NewTorrentDetails(api.NewMockClient())
+	details.torrent = &api.Torrent{Hash: "hash1"}
+	details.activeTab = TabPeers
+	details.peers = map[string]api.Peer{
+		"10.0.0.2:6881":       {IP: "10.0.0.2", Port: 6881, Client: "Deluge"},
+		"10.0.0.1:6881":       {IP: "10.0.0.1", Port: 6881, Client: "Transmission"},
+		"[2001:db8::1]:51413": {IP: "2001:db8::1", Port: 51413, Client: "qBittorrent"},
+	}
+	details.sortPeers()
+
+	ban := tea.KeyPressMsg{Code: 'b', Text: "b"}
+	if !details.HandlesKey(ban) {
+		t.Fatal("Peers tab should handle b")
+	}
+
+	details.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	_, cmd := details.Update(ban)
+	if req, ok := cmd().(PeerBanRequestMsg); !ok || req.Address != "10.0.0.2:6881" || req.Client != "Deluge" {
+		t.Errorf("ban should request the peer under the cursor, got %#v", req)
+	}
+
+	// The cursor follows its peer when others disconnect
+	delete(details.peers, "10.0.0.1:6881")
+	details.sortPeers()
+	if details.peerOrder[details.peerCursor] != "10.0.0.2:6881" {
+		t.Errorf("cursor moved to %s", details.peerOrder[details.peerCursor])
+	}
+
+	details.Update(tea.KeyPressMsg{Code: 'G', Text: "G"})
+	_, cmd = details.Update(ban)
+	if req, ok := cmd().(PeerBanRequestMsg); !ok || req.Address != "[2001:db8::1]:51413" {
+		t.Errorf("IPv6 peers should be bracketed, got %#v", req)
+	}
+
+	_, cmd = details.Update(tea.KeyPressMsg{Code: 'a', Text: "a"})
+	if req, ok := cmd().(AddPeersRequestMsg); !ok || req.Hash != "hash1" {
+		t.Errorf("add should request the add peers dialog, got %#v", req)
+	}
+}
+
+func TestPeersTabIncrementalSync(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Peers["hash1"] = map[string]api.Peer{
+		"10.0.0.1:6881": {IP: "10.0.0.1", Port: 6881, Client: "Transmission"},
+		"10.0.0.2:6881": {IP: "10.0.0.2", Port: 6881, Client: "Deluge"},
+	}
+	mock.TorrentProperties["hash1"] = &api.TorrentProperties{}
+
+	details := NewTorrentDetails(mock)
+	details.Update(details.SetTorrent(&api.Torrent{Hash: "hash1"})())
+	if len(details.peers) != 2 || details.peersRID == 0 {
+		t.Fatalf("first load should sync every peer, got %d peers rid %d", len(details.peers), details.peersRID)
+	}
+
+	mock.Peers["hash1"]["10.0.0.1:6881"] = api.Peer{IP: "10.0.0.1", Port: 6881, Client: "Transmission", DlSpeed: 4096}
+	delete(mock.Peers["hash1"], "10.0.0.2:6881")
+	msg := details.fetchDetailedData()().(DetailsDataMsg)
+	if msg.Peers.FullUpdate || len(msg.Peers.Peers) != 1 {
+		t.Fatalf("refresh should only send the changed peer, got %#v", msg.Peers)
+	}
+	details.Update(msg)
+	if len(details.peers) != 1 || details.peers["10.0.0.1:6881"].DlSpeed != 4096 {
+		t.Errorf("peers after update: %v", details.peers)
+	}
+
+	// Of two fetches started from the same peer sync, the later one is stale
+	// and must not bring back a peer the first one removed
+	base := details.peersRID
+	details.Update(DetailsDataMsg{Hash: "hash1", PeersBaseRID: base, Peers: &api.SyncTorrentPeersResponse{
+		RID: base + 1, PeersRemoved: []string{"10.0.0.1:6881"},
+	}})
+	details.Update(DetailsDataMsg{Hash: "hash1", PeersBaseRID: base, Peers: &api.SyncTorrentPeersResponse{
+		RID: base + 1, Peers: map[string]api.PartialPeer{"10.0.0.1:6881": {}},
+	}})
+	if len(details.peers) != 0 || details.peersRID != base+1 {
+		t.Errorf("overlapping delta should be dropped, got rid %d peers %v", details.peersRID, details.peers)
+	}
+
+	// Data for a torrent that is no longer shown is dropped
+	details.SetTorrent(&api.Torrent{Hash: "hash2"})
+	details.Update(msg)
+	if details.peers != nil || !details.isLoading {
+		t.Error("stale details data should be ignored")
+	}
+}
@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"unicode/utf8"
@@ -41,8 +42,16 @@ type TorrentList struct {
 	sortConfig     SortConfig // Current sort configuration
 	visibleColumns []string   // List of visible column keys
 	showConfig     bool       // Whether to show column config overlay
+
+	// Multi-select state
+	marked       map[string]struct{} // Hashes of marked torrents
+	visualActive bool                // Whether a visual range selection is in progress
+	visualAnchor string              // Hash of the row where the visual range started
 }
 
+// markerWidth is the width of the mark gutter in front of every row
+const markerWidth = 2
+
 // ColumnConfig represents a responsive table column
 type ColumnConfig struct {
 	Key      string  // Unique identifier for the column
@@ -57,21 +66,28 @@ type ColumnConfig struct {
 var allColumns = []ColumnConfig{
 	{Key: "name", Title: "Name", MinWidth: 20, MaxWidth: 0, FlexGrow: 0.6, Priority: 1},
 	{Key: "size", Title: "Size", MinWidth: 8, MaxWidth: 12, FlexGrow: 0.0, Priority: 3},
-	{Key: "progress", Title: "Progress", MinWidth: 10, MaxWidth: 13, FlexGrow: 0.0, Priority: 2}, // "Progress ↑" = 10 chars
-	{Key: "status", Title: "Status", MinWidth: 8, MaxWidth: 15, FlexGrow: 0.1, Priority: 2},      // "Status ↑" = 8 chars
-	{Key: "down", Title: "Down", MinWidth: 6, MaxWidth: 15, FlexGrow: 0.1, Priority: 3},          // "Down ↑" = 6 chars
-	{Key: "up", Title: "Up", MinWidth: 4, MaxWidth: 15, FlexGrow: 0.1, Priority: 4},              // "Up ↑" = 4 chars
-	{Key: "seeds", Title: "Seeds", MinWidth: 7, MaxWidth: 12, FlexGrow: 0.05, Priority: 4},       // "Seeds ↑" = 7 chars
-	{Key: "peers", Title: "Peers", MinWidth: 7, MaxWidth: 12, FlexGrow: 0.05, Priority: 5},       // "Peers ↑" = 7 chars
-	{Key: "ratio", Title: "Ratio", MinWidth: 7, MaxWidth: 10, FlexGrow: 0.0, Priority: 5},        // "Ratio ↑" = 7 chars
-	{Key: "uploaded", Title: "Uploaded", MinWidth: 10, MaxWidth: 15, FlexGrow: 0.0, Priority: 6}, // "Uploaded ↑" = 10 chars
-	{Key: "eta", Title: "ETA", MinWidth: 5, MaxWidth: 15, FlexGrow: 0.05, Priority: 7},           // "ETA ↑" = 5 chars
-	{Key: "added_on", Title: "Added", MinWidth: 7, MaxWidth: 20, FlexGrow: 0.05, Priority: 8},    // "Added ↑" = 7 chars
-	{Key: "category", Title: "Category", MinWidth: 10, MaxWidth: 20, FlexGrow: 0.1, Priority: 9}, // "Category ↑" = 10 chars
-	{Key: "tags", Title: "Tags", MinWidth: 6, MaxWidth: 25, FlexGrow: 0.1, Priority: 10},         // "Tags ↑" = 6 chars
-	{Key: "tracker", Title: "Tracker", MinWidth: 9, MaxWidth: 25, FlexGrow: 0.1, Priority: 11},   // "Tracker ↑" = 9 chars
+	{Key: "progress", Title: "Progress", MinWidth: 10, MaxWidth: 13, FlexGrow: 0.0, Priority: 2},          // "Progress ↑" = 10 chars
+	{Key: "status", Title: "Status", MinWidth: 8, MaxWidth: 15, FlexGrow: 0.1, Priority: 2},               // "Status ↑" = 8 chars
+	{Key: "down", Title: "Down", MinWidth: 6, MaxWidth: 15, FlexGrow: 0.1, Priority: 3},                   // "Down ↑" = 6 chars
+	{Key: "up", Title: "Up", MinWidth: 4, MaxWidth: 15, FlexGrow: 0.1, Priority: 4},                       // "Up ↑" = 4 chars
+	{Key: "seeds", Title: "Seeds", MinWidth: 7, MaxWidth: 12, FlexGrow: 0.05, Priority: 4},                // "Seeds ↑" = 7 chars
+	{Key: "peers", Title: "Peers", MinWidth: 7, MaxWidth: 12, FlexGrow: 0.05, Priority: 5},                // "Peers ↑" = 7 chars
+	{Key: "ratio", Title: "Ratio", MinWidth: 7, MaxWidth: 10, FlexGrow: 0.0, Priority: 5},                 // "Ratio ↑" = 7 chars
+	{Key: "uploaded", Title: "Uploaded", MinWidth: 10, MaxWidth: 15, FlexGrow: 0.0, Priority: 6},          // "Uploaded ↑" = 10 chars
+	{Key: "eta", Title: "ETA", MinWidth: 5, MaxWidth: 15, FlexGrow: 0.05, Priority: 7},                    // "ETA ↑" = 5 chars
+	{Key: "added_on", Title: "Added", MinWidth: 7, MaxWidth: 20, FlexGrow: 0.05, Priority: 8},             // "Added ↑" = 7 chars
+	{Key: "category", Title: "Category", MinWidth: 10, MaxWidth: 20, FlexGrow: 0.1, Priority: 9},          // "Category ↑" = 10 chars
+	{Key: "tags", Title: "Tags", MinWidth: 6, MaxWidth: 25, FlexGrow: 0.1, Priority: 10},                  // "Tags ↑" = 6 chars
+	{Key: "tracker", Title: "Tracker", MinWidth: 9, MaxWidth: 25, FlexGrow: 0.1, Priority: 11},            // "Tracker ↑" = 9 chars
+	{Key: "queue", Title: "Queue #", MinWidth: 9, MaxWidth: 10, FlexGrow: 0.0, Priority: 12},              // "Queue # ↑" = 9 chars
+	{Key: "seq_dl", Title: "Sequential", MinWidth: 12, MaxWidth: 12, FlexGrow: 0.0, Priority: 13},         // "Sequential ↑" = 12 chars
+	{Key: "f_l_piece_prio", Title: "First/Last", MinWidth: 12, MaxWidth: 12, FlexGrow: 0.0, Priority: 14}, // "First/Last ↑" = 12 chars
 }
 
+// columnToggleKeys are the keys that toggle allColumns in the column config
+// overlay, by index; letters avoid conflicts once the digits run out
+var columnToggleKeys = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0", "q", "w", "e", "r", "t", "y", "u", "i", "o", "p"}
+
 // Default visible columns
 var defaultVisibleColumns = []string{
 	"name", "size", "progress", "status", "down", "up", "seeds", "peers", "ratio",
@@ -89,6 +105,7 @@ func NewTorrentList() *TorrentList {
 		torrents:       []api.Torrent{},
 		showProgress:   true,
 		visibleColumns: append([]string{}, defaultVisibleColumns...), // Copy default columns
+		marked:         make(map[string]struct{}),
 		sortConfig: SortConfig{
 			Column:    "name",  // Default sort by name
 			Direction: SortAsc, // Ascending
@@ -143,6 +160,7 @@ func NewTorrentListWithColumns(columns []string, defaultSortColumn string, defau
 		torrents:       []api.Torrent{},
 		showProgress:   true,
 		visibleColumns: validCols,
+		marked:         make(map[string]struct{}),
 		sortConfig: SortConfig{
 			Column:    sortCol,
 			Direction: sortDir,
@@ -162,11 +180,17 @@ func (t *TorrentList) SetTorrents(torrents []api.Torrent) {
 		t.offset = 0
 	}
 
+	// Drop marks for torrents that are no longer visible so that bulk
+	// actions never touch torrents hidden by a filter or already removed
+	t.pruneMarks()
+
 	// Handle empty list
 	if len(t.torrents) == 0 {
 		t.cursor = 0
 		t.offset = 0
 		t.selectedHash = ""
+		t.visualActive = false
+		t.visualAnchor = ""
 		return
 	}
 
@@ -190,25 +214,15 @@ func (t *TorrentList) Update(msg tea.Msg) (*TorrentList, tea.Cmd) {
 			switch msg.String() {
 			case "C", "c", "esc":
 				t.showConfig = false
-			case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			default:
 				// Toggle column visibility
-				num := int(msg.String()[0] - '1')
-				t.ToggleColumn(num)
-			case "0":
-				// Handle 10th column
-				t.ToggleColumn(9)
-			case "q", "Q":
-				// Handle 11th column
-				t.ToggleColumn(10)
-			case "w", "W":
-				// Handle 12th column
-				t.ToggleColumn(11)
-			case "e", "E":
-				// Handle 13th column
-				t.ToggleColumn(12)
-			case "r", "R":
-				// Handle 14th column
-				t.ToggleColumn(13)
+				toggle := strings.ToLower(msg.String())
+				for i, k := range columnToggleKeys {
+					if k == toggle {
+						t.ToggleColumn(i)
+						break
+					}
+				}
 			}
 			return t, nil
 		}
@@ -348,7 +362,7 @@ func (t *TorrentList) renderHeader() string {
 			headers = append(headers, header)
 		}
 	}
-	return styles.HeaderStyle.Render(strings.Join(headers, " "))
+	return styles.HeaderStyle.Render(strings.Repeat(" ", markerWidth) + strings.Join(headers, " "))
 }
 
 // renderTorrent renders a single torrent row
@@ -356,7 +370,12 @@ func (t *TorrentList) renderTorrent(index int) string {
 	torrent := t.torrents[index]
 	isSelected := index == t.cursor
 
-	var cells []string
+	// Mark gutter
+	marker := strings.Repeat(" ", markerWidth)
+	if t.isMarked(index) {
+		marker = styles.AccentStyle.Render("●") + " "
+	}
+	cells := []string{marker}
 
 	for _, col := range t.columns {
 		var content string
@@ -408,6 +427,15 @@ func (t *TorrentList) renderTorrent(index int) string {
 		case "tracker":
 			content = styles.TruncateString(torrent.Tracker, col.Width)
 			style = lipgloss.NewStyle()
+		case "queue":
+			content = formatQueuePosition(torrent.Priority)
+			style = lipgloss.NewStyle()
+		case "seq_dl":
+			content = formatFlag(torrent.SeqDownload)
+			style = styles.AccentStyle
+		case "f_l_piece_prio":
+			content = formatFlag(torrent.FirstLastPiece)
+			style = styles.AccentStyle
 		default:
 			content = ""
 			style = lipgloss.NewStyle()
@@ -417,7 +445,7 @@ func (t *TorrentList) renderTorrent(index int) string {
 		cells = append(cells, cell)
 	}
 
-	row := strings.Join(cells, " ")
+	row := cells[0] + strings.Join(cells[1:], " ")
 
 	if isSelected {
 		return styles.SelectedRowStyle.Render(row)
@@ -425,6 +453,42 @@ func (t *TorrentList) renderTorrent(index int) string {
 	return row
 }
 
+// formatQueuePosition formats a queue position, showing * for torrents that
+// are not queued (seeding, or queueing disabled)
+func formatQueuePosition(priority int) string {
+	if priority <= 0 {
+		return "*"
+	}
+	return fmt.Sprintf("%d", priority)
+}
+
+// formatFlag formats an on/off torrent setting as a check mark
+func formatFlag(enabled bool) string {
+	if enabled {
+		return "✓"
+	}
+	return ""
+}
+
+// compareFlags orders disabled settings before enabled ones
+func compareFlags(a, b bool) int {
+	if a == b {
+		return 0
+	}
+	if !a {
+		return -1
+	}
+	return 1
+}
+
+// queueSortKey orders queued torrents by position, followed by unqueued ones
+func queueSortKey(priority int) int {
+	if priority <= 0 {
+		return math.MaxInt
+	}
+	return priority
+}
+
 // getStatusDisplay returns a human-readable status
 func (t *TorrentList) getStatusDisplay(state string) string {
 	switch state {
@@ -497,7 +561,7 @@ func (t *TorrentList) moveToBottom() {
 func (t *TorrentList) SetDimensions(width, height int) {
 	t.width = width
 	t.height = height
-	t.columns = t.calculateColumnWidths(width)
+	t.columns = t.calculateColumnWidths(width - markerWidth)
 }
 
 // calculateColumnWidths determines column widths based on available space
@@ -605,6 +669,144 @@ func (t *TorrentList) GetSelectedHash() string {
 	return t.selectedHash
 }
 
+// ToggleMark toggles the mark on the torrent under the cursor
+func (t *TorrentList) ToggleMark() {
+	if t.selectedHash == "" {
+		return
+	}
+	if _, ok := t.marked[t.selectedHash]; ok {
+		delete(t.marked, t.selectedHash)
+	} else {
+		t.marked[t.selectedHash] = struct{}{}
+	}
+}
+
+// ToggleVisual starts a visual range selection at the cursor, or commits the
+// current range into the marked set if one is already in progress
+func (t *TorrentList) ToggleVisual() {
+	if t.visualActive {
+		start, end := t.visualRange()
+		for i := start; i <= end; i++ {
+			t.marked[t.torrents[i].Hash] = struct{}{}
+		}
+		t.visualActive = false
+		t.visualAnchor = ""
+		return
+	}
+	if t.selectedHash == "" {
+		return
+	}
+	t.visualActive = true
+	t.visualAnchor = t.selectedHash
+}
+
+// MarkAll marks every torrent currently in the list (i.e. all visible torrents)
+func (t *TorrentList) MarkAll() {
+	t.visualActive = false
+	t.visualAnchor = ""
+	for _, torrent := range t.torrents {
+		t.marked[torrent.Hash] = struct{}{}
+	}
+}
+
+// InvertMarks marks every unmarked torrent in the list and unmarks the rest
+func (t *TorrentList) InvertMarks() {
+	t.visualActive = false
+	t.visualAnchor = ""
+	inverted := make(map[string]struct{}, len(t.torrents))
+	for _, torrent := range t.torrents {
+		if _, ok := t.marked[torrent.Hash]; !ok {
+			inverted[torrent.Hash] = struct{}{}
+		}
+	}
+	t.marked = inverted
+}
+
+// ClearMarks removes all marks and cancels any visual range selection
+func (t *TorrentList) ClearMarks() {
+	t.marked = make(map[string]struct{})
+	t.visualActive = false
+	t.visualAnchor = ""
+}
+
+// HasMarks returns true if any torrent is marked or a visual range is active
+func (t *TorrentList) HasMarks() bool {
+	return len(t.marked) > 0 || t.visualActive
+}
+
+// IsInVisualMode returns whether a visual range selection is in progress
+func (t *TorrentList) IsInVisualMode() bool {
+	return t.visualActive
+}
+
+// GetMarkedHashes returns the hashes of all marked torrents (including an
+// in-progress visual range) in display order
+func (t *TorrentList) GetMarkedHashes() []string {
+	var hashes []string
+	for i, torrent := range t.torrents {
+		if t.isMarked(i) {
+			hashes = append(hashes, torrent.Hash)
+		}
+	}
+	return hashes
+}
+
+// GetActionHashes returns the torrents an action should apply to: the marked
+// set if there is one, otherwise the torrent under the cursor
+func (t *TorrentList) GetActionHashes() []string {
+	if marked := t.GetMarkedHashes(); len(marked) > 0 {
+		return marked
+	}
+	if t.selectedHash != "" {
+		return []string{t.selectedHash}
+	}
+	return nil
+}
+
+// isMarked reports whether the row at index is marked or inside the visual range
+func (t *TorrentList) isMarked(index int) bool {
+	if _, ok := t.marked[t.torrents[index].Hash]; ok {
+		return true
+	}
+	if t.visualActive {
+		start, end := t.visualRange()
+		return index >= start && index <= end
+	}
+	return false
+}
+
+// visualRange returns the inclusive row range between the visual anchor and the cursor
+func (t *TorrentList) visualRange() (int, int) {
+	anchor := t.cursor
+	for i, torrent := range t.torrents {
+		if torrent.Hash == t.visualAnchor {
+			anchor = i
+			break
+		}
+	}
+	if anchor > t.cursor {
+		return t.cursor, anchor
+	}
+	return anchor, t.cursor
+}
+
+// pruneMarks drops marks (and the visual anchor) for torrents not in the list
+func (t *TorrentList) pruneMarks() {
+	present := make(map[string]struct{}, len(t.torrents))
+	for _, torrent := range t.torrents {
+		present[torrent.Hash] = struct{}{}
+	}
+	for hash := range t.marked {
+		if _, ok := present[hash]; !ok {
+			delete(t.marked, hash)
+		}
+	}
+	if _, ok := present[t.visualAnchor]; t.visualActive && !ok {
+		t.visualActive = false
+		t.visualAnchor = ""
+	}
+}
+
 // GetColumns returns the current column configuration (for testing)
 func (t *TorrentList) GetColumns() []Column {
 	return t.columns
@@ -747,6 +949,19 @@ func (t *TorrentList) compareByColumn(a, b api.Torrent, column string) int {
 		return strings.Compare(strings.ToLower(a.Tags), strings.ToLower(b.Tags))
 	case "tracker":
 		return strings.Compare(strings.ToLower(a.Tracker), strings.ToLower(b.Tracker))
+	case "seq_dl":
+		return compareFlags(a.SeqDownload, b.SeqDownload)
+	case "f_l_piece_prio":
+		return compareFlags(a.FirstLastPiece, b.FirstLastPiece)
+	case "queue":
+		// Torrents outside the queue sort after every queued one
+		aPos, bPos := queueSortKey(a.Priority), queueSortKey(b.Priority)
+		if aPos < bPos {
+			return -1
+		} else if aPos > bPos {
+			return 1
+		}
+		return 0
 	default:
 		// Unknown column, fall back to name
 		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
@@ -784,7 +999,7 @@ func (t *TorrentList) renderWithColumnConfig() string {
 
 	// Instructions
 	content.WriteString(lipgloss.PlaceHorizontal(contentWidth, lipgloss.Center,
-		styles.DimStyle.Render("Toggle column visibility: 1-9, 0, q, w, e, r")))
+		styles.DimStyle.Render("Toggle column visibility: "+columnToggleHelp())))
 	content.WriteString("\n\n")
 
 	// Two-column layout for the column list
@@ -812,20 +1027,7 @@ func (t *TorrentList) renderWithColumnConfig() string {
 		}
 
 		// Format with proper spacing
-		var num string
-		if i < 9 {
-			num = fmt.Sprintf("%d.", i+1)
-		} else if i == 9 {
-			num = "0." // Use 0 for 10th item
-		} else {
-			// Use q, w, e, r for columns 11-14 to avoid conflicts
-			extraKeys := []string{"q", "w", "e", "r"}
-			if i-10 < len(extraKeys) {
-				num = fmt.Sprintf("%s.", extraKeys[i-10])
-			} else {
-				num = fmt.Sprintf("%d.", i+1)
-			}
-		}
+		num := columnToggleLabel(i)
 		line := fmt.Sprintf("%-3s %s %-15s", num, checkStyle.Render(checkbox), config.Title)
 
 		if isVisible {
@@ -855,21 +1057,8 @@ func (t *TorrentList) renderWithColumnConfig() string {
 			checkStyle = styles.AccentStyle
 		}
 
-		// Format with proper spacing - handle numbers > 9
-		var num string
-		if i < 9 {
-			num = fmt.Sprintf("%d.", i+1)
-		} else if i == 9 {
-			num = "0."
-		} else {
-			// Use q, w, e, r for columns 11-14 to avoid conflicts
-			extraKeys := []string{"q", "w", "e", "r"}
-			if i-10 < len(extraKeys) {
-				num = fmt.Sprintf("%s.", extraKeys[i-10])
-			} else {
-				num = fmt.Sprintf("%d.", i+1)
-			}
-		}
+		// Format with proper spacing
+		num := columnToggleLabel(i)
 		line := fmt.Sprintf("%-3s %s %-15s", num, checkStyle.Render(checkbox), config.Title)
 
 		if isVisible {
@@ -923,6 +1112,29 @@ func (t *TorrentList) renderWithColumnConfig() string {
 	return finalView
 }
 
+// columnToggleLabel returns the config overlay label for the column at index
+func columnToggleLabel(index int) string {
+	if index < len(columnToggleKeys) {
+		return columnToggleKeys[index] + "."
+	}
+	return ""
+}
+
+// columnToggleHelp lists the toggle keys in use, e.g. "1-9, 0, q-t"
+func columnToggleHelp() string {
+	n := min(len(allColumns), len(columnToggleKeys))
+	help := fmt.Sprintf("1-%s", columnToggleKeys[min(n, 9)-1])
+	if n > 9 {
+		help += ", 0"
+	}
+	if n > 11 {
+		help += ", q-" + columnToggleKeys[n-1]
+	} else if n > 10 {
+		help += ", q"
+	}
+	return help
+}
+
 // ToggleColumn toggles visibility of a column by index (0-based)
 func (t *TorrentList) ToggleColumn(index int) {
 	if index < 0 || index >= len(allColumns) {
@@ -949,7 +1161,7 @@ func (t *TorrentList) ToggleColumn(index int) {
 	}
 
 	// Recalculate column widths
-	t.columns = t.calculateColumnWidths(t.width)
+	t.columns = t.calculateColumnWidths(t.width - markerWidth)
 }
 
 // GetVisibleColumns returns the list of visible column keys
@@ -960,7 +1172,7 @@ func (t *TorrentList) GetVisibleColumns() []string {
 // SetVisibleColumns sets the list of visible columns
 func (t *TorrentList) SetVisibleColumns(columns []string) {
 	t.visibleColumns = append([]string{}, columns...)
-	t.columns = t.calculateColumnWidths(t.width)
+	t.columns = t.calculateColumnWidths(t.width - markerWidth)
 }
 
 // IsInConfigMode returns whether the column configuration overlay is shown
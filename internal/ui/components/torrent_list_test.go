@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/nickvanw/qbittorrent-tui/internal/api"
 )
 
@@ -203,6 +204,43 @@ func TestTorrentSorting(t *testing.T) {
 	}
 }
 
+func TestQueueColumnSorting(t *testing.T) {
+	torrentList := NewTorrentList()
+	torrentList.SetTorrents([]api.Torrent{
+		{Name: "Seeding", Priority: 0},
+		{Name: "Second", Priority: 2},
+		{Name: "First", Priority: 1},
+	})
+
+	// Torrents outside the queue come after every queued one
+	torrentList.setSortColumn("queue", false)
+	var names []string
+	for _, torrent := range torrentList.torrents {
+		names = append(names, torrent.Name)
+	}
+	if strings.Join(names, ",") != "First,Second,Seeding" {
+		t.Errorf("queue order: got %v", names)
+	}
+
+	if formatQueuePosition(0) != "*" || formatQueuePosition(3) != "3" {
+		t.Error("unqueued torrents should show * and queued ones their position")
+	}
+}
+
+func TestColumnToggleKeys(t *testing.T) {
+	if len(columnToggleKeys) < len(allColumns) {
+		t.Fatalf("every column needs a toggle key: %d keys for %d columns", len(columnToggleKeys), len(allColumns))
+	}
+
+	torrentList := NewTorrentList()
+	torrentList.showConfig = true
+	lastIndex := len(allColumns) - 1
+	torrentList.Update(tea.KeyPressMsg{Code: rune(columnToggleKeys[lastIndex][0]), Text: columnToggleKeys[lastIndex]})
+	if !strings.Contains(strings.Join(torrentList.GetVisibleColumns(), ","), allColumns[lastIndex].Key) {
+		t.Errorf("toggle key %q should show the %s column", columnToggleKeys[lastIndex], allColumns[lastIndex].Key)
+	}
+}
+
 func TestSortIndicators(t *testing.T) {
 	torrentList := NewTorrentList()
 	torrentList.SetDimensions(200, 20)
@@ -268,3 +306,112 @@ func TestSelectionClearedOnEmptyList(t *testing.T) {
 		t.Fatalf("expected cursor reset to 0, got %d", torrentList.cursor)
 	}
 }
+
+func TestMarkedSelection(t *testing.T) {
+	torrentList := NewTorrentList()
+	torrentList.SetTorrents([]api.Torrent{
+		{Name: "Alpha", Hash: "a"},
+		{Name: "Beta", Hash: "b"},
+		{Name: "Gamma", Hash: "c"},
+		{Name: "Delta", Hash: "d"},
+	})
+	// Sorted by name: Alpha, Beta, Delta, Gamma
+
+	// Without marks, actions target the cursor row
+	if got := torrentList.GetActionHashes(); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected cursor hash as action target, got %v", got)
+	}
+
+	torrentList.ToggleMark()
+	torrentList.moveDown()
+	torrentList.moveDown()
+	torrentList.ToggleMark()
+	if got := torrentList.GetActionHashes(); len(got) != 2 || got[0] != "a" || got[1] != "d" {
+		t.Fatalf("expected marked hashes [a d] in display order, got %v", got)
+	}
+
+	// Toggling again unmarks
+	torrentList.ToggleMark()
+	if got := torrentList.GetMarkedHashes(); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected [a] after unmarking, got %v", got)
+	}
+
+	torrentList.InvertMarks()
+	if got := torrentList.GetMarkedHashes(); len(got) != 3 || got[0] != "b" {
+		t.Fatalf("expected [b d c] after invert, got %v", got)
+	}
+
+	torrentList.ClearMarks()
+	if torrentList.HasMarks() {
+		t.Fatal("expected no marks after clear")
+	}
+
+	torrentList.MarkAll()
+	if got := torrentList.GetMarkedHashes(); len(got) != 4 {
+		t.Fatalf("expected all 4 torrents marked, got %v", got)
+	}
+}
+
+func TestVisualRangeSelection(t *testing.T) {
+	torrentList := NewTorrentList()
+	torrentList.SetTorrents([]api.Torrent{
+		{Name: "Alpha", Hash: "a"},
+		{Name: "Beta", Hash: "b"},
+		{Name: "Gamma", Hash: "c"},
+	})
+
+	torrentList.moveDown()
+	torrentList.ToggleVisual()
+	if !torrentList.IsInVisualMode() {
+		t.Fatal("expected visual mode to be active")
+	}
+	torrentList.moveDown()
+
+	// The in-progress range already counts as marked
+	if got := torrentList.GetMarkedHashes(); len(got) != 2 || got[0] != "b" || got[1] != "c" {
+		t.Fatalf("expected visual range [b c], got %v", got)
+	}
+
+	// Committing keeps the range marked after the cursor moves away
+	torrentList.ToggleVisual()
+	torrentList.moveToTop()
+	if torrentList.IsInVisualMode() {
+		t.Fatal("expected visual mode to end after commit")
+	}
+	if got := torrentList.GetMarkedHashes(); len(got) != 2 {
+		t.Fatalf("expected committed range to stay marked, got %v", got)
+	}
+}
+
+func TestMarksPrunedWhenTorrentsHidden(t *testing.T) {
+	torrentList := NewTorrentList()
+	torrentList.SetTorrents([]api.Torrent{
+		{Name: "Alpha", Hash: "a"},
+		{Name: "Beta", Hash: "b"},
+	})
+	torrentList.MarkAll()
+
+	// Simulate a filter hiding "b"
+	torrentList.SetTorrents([]api.Torrent{
+		{Name: "Alpha", Hash: "a"},
+	})
+	if got := torrentList.GetMarkedHashes(); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("expected hidden torrent to be unmarked, got %v", got)
+	}
+}
+
+func TestMarkerGutterRendered(t *testing.T) {
+	torrentList := NewTorrentList()
+	torrentList.SetDimensions(120, 10)
+	torrentList.SetTorrents([]api.Torrent{
+		{Name: "Alpha", Hash: "a"},
+		{Name: "Beta", Hash: "b"},
+	})
+	torrentList.moveDown()
+	torrentList.ToggleMark()
+
+	view := torrentList.View()
+	if strings.Count(view, "●") != 1 {
+		t.Errorf("expected exactly one marker in view, got:\n%s", view)
+	}
+}
@@ -2,6 +2,9 @@ package styles
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -149,6 +152,105 @@ func FormatSpeed(bytesPerSec int64) string {
 	return FormatBytes(bytesPerSec) + "/s"
 }
 
+// FormatSpeedLimit formats a speed limit, showing ∞ when unlimited (0 or negative)
+func FormatSpeedLimit(bytesPerSec int64) string {
+	if bytesPerSec <= 0 {
+		return "∞"
+	}
+	return FormatSpeed(bytesPerSec)
+}
+
+// speedUnits lists accepted unit suffixes, longest first so "kib" wins over "b"
+var speedUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"gib", 1 << 30}, {"gb", 1 << 30}, {"g", 1 << 30},
+	{"mib", 1 << 20}, {"mb", 1 << 20}, {"m", 1 << 20},
+	{"kib", 1 << 10}, {"kb", 1 << 10}, {"k", 1 << 10},
+	{"b", 1},
+}
+
+// ParseSpeedLimit parses a human-friendly speed limit such as "2.5M", "500K"
+// or "1.5 MB/s" into bytes per second. Units are binary (1K = 1024 bytes), a
+// bare number is taken as KiB/s like qBittorrent's own limit fields, and an
+// empty string, "0", "-" or "unlimited" mean no limit (0).
+func ParseSpeedLimit(s string) (int64, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	switch value {
+	case "", "0", "-", "∞", "unlimited", "none":
+		return 0, nil
+	}
+
+	value = strings.TrimSpace(strings.TrimSuffix(value, "/s"))
+
+	multiplier := float64(1024) // Bare numbers are KiB/s
+	for _, unit := range speedUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			multiplier = unit.multiplier
+			value = strings.TrimSuffix(value, unit.suffix)
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	bytes := number * multiplier
+	// Limits past int64 would overflow into a garbage or negative limit
+	if err != nil || number < 0 || math.IsNaN(number) || bytes >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid speed limit %q (examples: 500K, 2.5M, 1G)", s)
+	}
+	return int64(bytes), nil
+}
+
+// ParseSeedingTime parses a seeding time limit such as "90", "45m", "12h",
+// "7d" or "1d12h" into minutes. A bare number is taken as minutes like
+// qBittorrent's own share limit fields.
+func ParseSeedingTime(s string) (int64, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	invalid := fmt.Errorf("invalid seeding time %q (examples: 90, 12h, 7d)", s)
+
+	if minutes, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if minutes < 0 {
+			return 0, invalid
+		}
+		return minutes, nil
+	}
+
+	// time.ParseDuration has no day unit, so split off a leading "<n>d"
+	var total time.Duration
+	if days, rest, ok := strings.Cut(value, "d"); ok {
+		number, err := strconv.ParseFloat(strings.TrimSpace(days), 64)
+		if err != nil || number < 0 || math.IsInf(number, 0) || math.IsNaN(number) {
+			return 0, invalid
+		}
+		total = time.Duration(number * float64(24*time.Hour))
+		value = strings.TrimSpace(rest)
+	}
+	if value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			return 0, invalid
+		}
+		total += d
+	}
+	if total == 0 && strings.TrimSpace(s) == "" {
+		return 0, invalid
+	}
+	return int64(total.Minutes()), nil
+}
+
+// FormatSeedingTime formats a seeding time limit in minutes, showing ∞ when
+// unlimited (negative)
+func FormatSeedingTime(minutes int64) string {
+	if minutes < 0 {
+		return "∞"
+	}
+	if minutes == 0 {
+		return "0m"
+	}
+	return FormatDuration(minutes * 60)
+}
+
 // TruncateString truncates a string to a maximum length with ellipsis
 func TruncateString(s string, maxLen int) string {
 	if utf8.RuneCountInString(s) <= maxLen {
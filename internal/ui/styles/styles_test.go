@@ -0,0 +1,86 @@
+package styles
+
+import "testing"
+
+func TestParseSpeedLimit(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"0", 0, false},
+		{"unlimited", 0, false},
+		{"500K", 500 * 1024, false},
+		{"2.5M", 2621440, false},
+		{"1G", 1 << 30, false},
+		{"1.5 MB/s", 1572864, false},
+		{"100 KiB/s", 100 * 1024, false},
+		{"300", 300 * 1024, false}, // Bare numbers are KiB/s
+		{"2048B", 2048, false},
+		{"fast", 0, true},
+		{"-5M", 0, true},
+		{"inf", 0, true},
+		{"1e20G", 0, true},
+		{"9e9G", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSpeedLimit(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSpeedLimit(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSpeedLimit(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseSpeedLimitRoundTrip(t *testing.T) {
+	// Limits shown by FormatSpeedLimit can be typed back in
+	limit := int64(2 * 1024 * 1024)
+	got, err := ParseSpeedLimit(FormatSpeedLimit(limit))
+	if err != nil || got != limit {
+		t.Errorf("round trip of %d: got %d, %v", limit, got, err)
+	}
+	if FormatSpeedLimit(0) != "∞" || FormatSpeedLimit(-1) != "∞" {
+		t.Error("zero and negative limits should format as ∞")
+	}
+}
+
+func TestParseSeedingTime(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"90", 90, false},
+		{"0", 0, false},
+		{"45m", 45, false},
+		{"12h", 720, false},
+		{"1h30m", 90, false},
+		{"7d", 7 * 24 * 60, false},
+		{"1.5d", 36 * 60, false},
+		{"1d12h", 36 * 60, false},
+		{"", 0, true},
+		{"-5", 0, true},
+		{"soon", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSeedingTime(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSeedingTime(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSeedingTime(%q) = %d, want %d", tt.input, got, tt.want)
+		}
+	}
+
+	// Formatted limits can be typed back in
+	for _, minutes := range []int64{45, 90, 720, 36 * 60, 7 * 24 * 60} {
+		if got, err := ParseSeedingTime(FormatSeedingTime(minutes)); err != nil || got != minutes {
+			t.Errorf("round trip of %d: got %d, %v", minutes, got, err)
+		}
+	}
+}
@@ -20,6 +20,7 @@ type TitleData struct {
 	DlTorrents        int    // Number of downloading torrents
 	UpTorrents        int    // Number of uploading torrents
 	PausedTorrents    int    // Number of paused torrents
+	AltSpeedEnabled   bool   // Alternative speed limits active
 }
 
 // SetTerminalTitle returns the ANSI escape sequence to set the terminal window title
@@ -56,6 +57,15 @@ func formatBytes(bytes int64) string {
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
+// altSpeedIndicator returns the {alt_speed} marker, empty when alternative
+// speed limits are off so templates collapse cleanly
+func altSpeedIndicator(enabled bool) string {
+	if enabled {
+		return "[ALT]"
+	}
+	return ""
+}
+
 // RenderTitle renders a title template with the provided data
 // Template uses {variable} syntax for placeholders
 func RenderTitle(template string, data TitleData) (string, error) {
@@ -77,6 +87,7 @@ func RenderTitle(template string, data TitleData) (string, error) {
 		"{dl_torrents}":        fmt.Sprintf("%d", data.DlTorrents),
 		"{up_torrents}":        fmt.Sprintf("%d", data.UpTorrents),
 		"{paused_torrents}":    fmt.Sprintf("%d", data.PausedTorrents),
+		"{alt_speed}":          altSpeedIndicator(data.AltSpeedEnabled),
 	}
 
 	// Replace all variables
@@ -100,6 +111,7 @@ func ValidateTemplate(template string) error {
 		"{active_torrents}", "{total_torrents}",
 		"{dl_torrents}", "{up_torrents}", "{paused_torrents}",
 		"{session_downloaded}", "{session_uploaded}",
+		"{alt_speed}",
 	}
 
 	// Find all {variable} patterns
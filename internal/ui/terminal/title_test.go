@@ -72,6 +72,22 @@ func TestRenderTitle(t *testing.T) {
 			contains: []string{"Session: ↓", "↑"},
 			wantErr:  false,
 		},
+		{
+			name:     "alt speed indicator",
+			template: "qbt{alt_speed} ↓{dl_speed}",
+			data: TitleData{
+				AltSpeedEnabled: true,
+			},
+			contains: []string{"qbt[ALT] ↓"},
+			wantErr:  false,
+		},
+		{
+			name:     "alt speed indicator hidden when off",
+			template: "qbt{alt_speed} ↓{dl_speed}",
+			data:     TitleData{},
+			contains: []string{"qbt ↓"},
+			wantErr:  false,
+		},
 		{
 			name:     "all variables combined",
 			template: "{server_url} [{active_torrents}/{total_torrents}] ↓{dl_speed} ↑{up_speed} D:{dl_torrents} U:{up_torrents} P:{paused_torrents} Session:↓{session_downloaded}↑{session_uploaded}",
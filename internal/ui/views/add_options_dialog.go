@@ -0,0 +1,366 @@
+package views
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// Indexes of the add torrent options fields
+const (
+	AddFieldSavePath = iota
+	AddFieldCategory
+	AddFieldTags
+	AddFieldRename
+	AddFieldContentLayout
+	AddFieldPaused
+	AddFieldSkipChecking
+	AddFieldSequential
+	AddFieldRatioLimit
+	AddFieldSeedingTime
+	AddFieldInactiveTime
+	addFieldCount
+)
+
+// addContentLayouts lists the content layouts to choose from; the empty
+// layout keeps the server default
+var addContentLayouts = []string{"", api.ContentLayoutOriginal, api.ContentLayoutSubfolder, api.ContentLayoutNoSubfolder}
+
+// AddOptionsForm is the options step of the add torrent dialog, shown once
+// the torrent file or URL is picked
+type AddOptionsForm struct {
+	source string // Torrent file path or URL
+	isFile bool
+
+	savePath      string
+	categories    []string // Server categories, with "" for none first
+	categoryIndex int
+	existingTags  []string
+	tags          string // Comma-separated
+	rename        string
+	layoutIndex   int
+	paused        bool
+	skipChecking  bool
+	sequential    bool
+	limits        [3]ShareLimitField
+
+	focusedField int
+
+	// Server directory browser for the save path
+	browsing  bool
+	remoteNav *RemoteFileNavigator
+}
+
+// openAddOptions moves the add torrent dialog on to the options step for
+// the picked torrent file or URL
+func (m *MainView) openAddOptions(source string, isFile bool) {
+	m.addDialog.options = &AddOptionsForm{
+		source:       source,
+		isFile:       isFile,
+		categories:   append([]string{""}, m.extractCategoryNames()...),
+		existingTags: m.tags,
+		limits: [3]ShareLimitField{
+			{label: "Ratio limit"},
+			{label: "Seeding time"},
+			{label: "Inactive seeding"},
+		},
+		remoteNav: &RemoteFileNavigator{apiClient: m.apiClient, directories: []string{}},
+	}
+}
+
+// textInput returns the focused text field, or nil if it isn't one
+func (f *AddOptionsForm) textInput() *string {
+	switch f.focusedField {
+	case AddFieldSavePath:
+		return &f.savePath
+	case AddFieldTags:
+		return &f.tags
+	case AddFieldRename:
+		return &f.rename
+	}
+	return nil
+}
+
+// toggle returns the focused switch, or nil if it isn't one
+func (f *AddOptionsForm) toggle() *bool {
+	switch f.focusedField {
+	case AddFieldPaused:
+		return &f.paused
+	case AddFieldSkipChecking:
+		return &f.skipChecking
+	case AddFieldSequential:
+		return &f.sequential
+	}
+	return nil
+}
+
+// limitField returns the focused share limit, or nil if it isn't one
+func (f *AddOptionsForm) limitField() *ShareLimitField {
+	if f.focusedField >= AddFieldRatioLimit {
+		return &f.limits[f.focusedField-AddFieldRatioLimit]
+	}
+	return nil
+}
+
+// appendInput adds typed or pasted text to the focused field; share limits
+// switch to a custom limit
+func (f *AddOptionsForm) appendInput(text string) {
+	if input := f.textInput(); input != nil {
+		*input = appendPrintable(*input, text)
+	} else if field := f.limitField(); field != nil {
+		field.mode = ShareLimitModeCustom
+		field.input = appendPrintable(field.input, text)
+	}
+}
+
+// cycle moves the focused choice forward or back by delta
+func (f *AddOptionsForm) cycle(delta int) {
+	wrap := func(i, n int) int { return (i + delta + n) % n }
+	switch f.focusedField {
+	case AddFieldCategory:
+		f.categoryIndex = wrap(f.categoryIndex, len(f.categories))
+	case AddFieldContentLayout:
+		f.layoutIndex = wrap(f.layoutIndex, len(addContentLayouts))
+	default:
+		if field := f.limitField(); field != nil {
+			field.mode = ShareLimitMode(wrap(int(field.mode), 3))
+		}
+	}
+}
+
+// options converts the form into API add options
+func (f *AddOptionsForm) options() (api.AddTorrentOptions, error) {
+	opts := api.AddTorrentOptions{
+		SavePath:      strings.TrimSpace(f.savePath),
+		Category:      f.categories[f.categoryIndex],
+		Paused:        f.paused,
+		SkipChecking:  f.skipChecking,
+		Sequential:    f.sequential,
+		ContentLayout: addContentLayouts[f.layoutIndex],
+		Rename:        strings.TrimSpace(f.rename),
+	}
+	for _, tag := range strings.Split(f.tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			opts.Tags = append(opts.Tags, tag)
+		}
+	}
+
+	for _, field := range f.limits {
+		if field.mode != ShareLimitModeGlobal {
+			limits, err := parseShareLimits(f.limits)
+			if err != nil {
+				return opts, err
+			}
+			opts.Limits = &limits
+			break
+		}
+	}
+	return opts, nil
+}
+
+// handleAddOptionsKeys handles keyboard input for the add torrent options step
+func (m *MainView) handleAddOptionsKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	f := m.addDialog.options
+
+	if f.browsing {
+		if keyMsg.String() == "esc" {
+			f.browsing = false
+			return nil
+		}
+		return m.handleRemoteBrowserKeys(f.remoteNav, keyMsg.String(), func(dir string) tea.Cmd {
+			f.savePath = dir
+			f.browsing = false
+			return nil
+		})
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		// Back to picking the torrent
+		m.addDialog.options = nil
+	case "tab", "down":
+		f.focusedField = (f.focusedField + 1) % addFieldCount
+	case "shift+tab", "up":
+		f.focusedField = (f.focusedField + addFieldCount - 1) % addFieldCount
+	case "right":
+		f.cycle(1)
+	case "left":
+		f.cycle(-1)
+	case "enter":
+		return m.submitAddOptions()
+	case "ctrl+b":
+		f.browsing = true
+		f.remoteNav.currentPath = strings.TrimSpace(f.savePath)
+		if f.remoteNav.currentPath == "" {
+			f.remoteNav.currentPath = "/"
+		}
+		f.remoteNav.selectedIdx = 0
+		return m.loadDirectoryContent(f.remoteNav.currentPath)
+	case "space":
+		if toggle := f.toggle(); toggle != nil {
+			*toggle = !*toggle
+		} else {
+			f.appendInput(" ")
+		}
+	case "backspace":
+		if input := f.textInput(); input != nil {
+			*input = deleteLastRune(*input)
+		} else if field := f.limitField(); field != nil && field.mode == ShareLimitModeCustom {
+			field.input = deleteLastRune(field.input)
+		}
+	case "ctrl+u":
+		if input := f.textInput(); input != nil {
+			*input = ""
+		} else if field := f.limitField(); field != nil {
+			field.input = ""
+		}
+	default:
+		if len(keyMsg.Text) > 0 {
+			f.appendInput(keyMsg.Text)
+		}
+	}
+
+	return nil
+}
+
+// submitAddOptions validates the options and adds the torrent
+func (m *MainView) submitAddOptions() tea.Cmd {
+	f := m.addDialog.options
+	opts, err := f.options()
+	if err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+
+	if f.isFile {
+		return m.addTorrentFile(f.source, opts)
+	}
+	return m.addTorrentURL(f.source, opts)
+}
+
+// renderAddOptions renders the options step of the add torrent dialog
+func (m *MainView) renderAddOptions() string {
+	f := m.addDialog.options
+
+	source := f.source
+	if f.isFile {
+		source = filepath.Base(source)
+	}
+	header := lipgloss.JoinVertical(lipgloss.Center,
+		styles.AccentStyle.Render("Add Torrent"),
+		styles.DimStyle.Render(styles.TruncateString(source, 60)),
+		"",
+	)
+
+	if f.browsing {
+		return lipgloss.JoinVertical(lipgloss.Center,
+			header,
+			"Save path:",
+			renderRemoteBrowser(f.remoteNav, "Enter: Use as save path"),
+			"",
+			styles.DimStyle.Render("Esc: Back to options"),
+		)
+	}
+
+	// Long text values show their end, where typing happens
+	text := func(value, placeholder string, focused bool) string {
+		if len(value) > 40 {
+			value = "…" + value[len(value)-39:]
+		}
+		switch {
+		case focused:
+			return styles.AccentStyle.Render(value + "▊")
+		case value == "":
+			return styles.DimStyle.Render(placeholder)
+		}
+		return styles.TextStyle.Render(value)
+	}
+	choice := func(value string, focused bool) string {
+		if focused {
+			return styles.AccentStyle.Render("‹ " + value + " ›")
+		}
+		return styles.TextStyle.Render("  " + value)
+	}
+	check := func(on bool) string {
+		if on {
+			return styles.AccentStyle.Render("[x]")
+		}
+		return styles.DimStyle.Render("[ ]")
+	}
+	limit := func(field ShareLimitField, focused bool) string {
+		var modes []string
+		for _, mode := range []ShareLimitMode{ShareLimitModeGlobal, ShareLimitModeUnlimited, ShareLimitModeCustom} {
+			if mode == field.mode {
+				modes = append(modes, styles.AccentStyle.Render("["+mode.String()+"]"))
+			} else {
+				modes = append(modes, styles.DimStyle.Render(" "+mode.String()+" "))
+			}
+		}
+		display := strings.Join(modes, " ")
+		if field.mode == ShareLimitModeCustom {
+			display += "  " + text(field.input, "", focused)
+		}
+		return display
+	}
+
+	category := f.categories[f.categoryIndex]
+	if category == "" {
+		category = "none"
+	}
+	layout := addContentLayouts[f.layoutIndex]
+	if layout == "" {
+		layout = "server default"
+	}
+
+	values := [addFieldCount]string{
+		AddFieldSavePath:      text(f.savePath, "server default (Ctrl+B to browse)", f.focusedField == AddFieldSavePath),
+		AddFieldCategory:      choice(category, f.focusedField == AddFieldCategory),
+		AddFieldTags:          text(f.tags, "comma-separated", f.focusedField == AddFieldTags),
+		AddFieldRename:        text(f.rename, "keep the torrent's name", f.focusedField == AddFieldRename),
+		AddFieldContentLayout: choice(layout, f.focusedField == AddFieldContentLayout),
+		AddFieldPaused:        check(f.paused),
+		AddFieldSkipChecking:  check(f.skipChecking),
+		AddFieldSequential:    check(f.sequential),
+	}
+	labels := [addFieldCount]string{"Save path", "Category", "Tags", "Rename", "Content layout", "Start paused", "Skip hash check", "Sequential"}
+	for i, field := range f.limits {
+		labels[AddFieldRatioLimit+i] = field.label
+		values[AddFieldRatioLimit+i] = limit(field, f.focusedField == AddFieldRatioLimit+i)
+	}
+
+	var rows []string
+	for i := range addFieldCount {
+		marker := "  "
+		label := styles.DimStyle.Render(fmt.Sprintf("%-17s", labels[i]))
+		if i == f.focusedField {
+			marker = styles.AccentStyle.Render("▸ ")
+			label = styles.TextStyle.Render(fmt.Sprintf("%-17s", labels[i]))
+		}
+		rows = append(rows, marker+label+values[i])
+	}
+
+	// Hint for the focused field
+	var hint string
+	switch {
+	case f.focusedField == AddFieldTags && len(f.existingTags) > 0:
+		hint = "Existing tags: " + styles.TruncateString(strings.Join(f.existingTags, ", "), 50)
+	case f.limitField() != nil:
+		hint = "Times e.g. 90 (minutes), 12h, 7d"
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		header,
+		lipgloss.JoinVertical(lipgloss.Left, rows...),
+		"",
+		styles.DimStyle.Render(hint),
+		"",
+		styles.DimStyle.Render("Tab/↑↓: Field  ←/→: Choose  Space: Toggle"),
+		styles.DimStyle.Render("Ctrl+B: Browse save path  Enter: Add  Esc: Back"),
+	)
+}
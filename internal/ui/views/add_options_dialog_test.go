@@ -0,0 +1,115 @@
+package views
+
+import (
+	"reflect"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func newAddOptionsTestView(t *testing.T) (*MainView, *api.MockClient) {
+	t.Helper()
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.categories = map[string]api.Category{"linux": {Name: "linux"}, "movies": {Name: "movies"}}
+	m.tags = []string{"iso"}
+	m.showAddDialog = true
+	m.addDialog.urlInput.url = "magnet:?xt=urn:btih:abc"
+
+	pressKeys(m, "enter")
+	if m.addDialog.options == nil {
+		t.Fatal("enter on the URL should move on to the options")
+	}
+	return m, mock
+}
+
+// focusAddField moves the focus down to an options field
+func focusAddField(m *MainView, field int) {
+	for m.addDialog.options.focusedField != field {
+		pressKeys(m, "down")
+	}
+}
+
+func TestAddOptionsSendsOptions(t *testing.T) {
+	m, mock := newAddOptionsTestView(t)
+
+	// Browse the server for the save path
+	runCmd(m, pressKeys(m, "ctrl+b"))
+	runCmd(m, pressKeys(m, "down", "l"))
+	pressKeys(m, "down", "enter") // Past ".."
+	f := m.addDialog.options
+	if f.browsing || f.savePath != "/downloads/complete" {
+		t.Fatalf("enter should pick the directory as save path, got %q", f.savePath)
+	}
+
+	focusAddField(m, AddFieldCategory)
+	pressKeys(m, "right")
+	focusAddField(m, AddFieldTags)
+	m.Update(tea.PasteMsg{Content: "iso, linux ,"})
+	focusAddField(m, AddFieldRename)
+	pressKeys(m, "D", "e", "b", "space", "1", "3")
+	focusAddField(m, AddFieldContentLayout)
+	pressKeys(m, "right", "right")
+	focusAddField(m, AddFieldPaused)
+	pressKeys(m, "space")
+	focusAddField(m, AddFieldSequential)
+	pressKeys(m, "space")
+	focusAddField(m, AddFieldRatioLimit)
+	pressKeys(m, "2")
+
+	runCmd(m, pressKeys(m, "enter"))
+	if m.showAddDialog || m.addDialog.options != nil {
+		t.Error("adding should close the dialog")
+	}
+	if len(mock.AddedURLs) != 1 || mock.AddedURLs[0] != "magnet:?xt=urn:btih:abc" {
+		t.Fatalf("torrent should be added from the URL, got %v", mock.AddedURLs)
+	}
+	want := api.AddTorrentOptions{
+		SavePath:      "/downloads/complete",
+		Category:      "linux",
+		Tags:          []string{"iso", "linux"},
+		Paused:        true,
+		Sequential:    true,
+		ContentLayout: api.ContentLayoutSubfolder,
+		Rename:        "Deb 13",
+		Limits: &api.ShareLimits{
+			RatioLimit:               2,
+			SeedingTimeLimit:         api.ShareLimitGlobal,
+			InactiveSeedingTimeLimit: api.ShareLimitGlobal,
+		},
+	}
+	if got := mock.AddOptions[0]; !reflect.DeepEqual(got, want) {
+		t.Errorf("options = %+v, want %+v", got, want)
+	}
+}
+
+func TestAddOptionsDefaults(t *testing.T) {
+	m, mock := newAddOptionsTestView(t)
+
+	// Esc goes back to the URL, and Enter comes back to fresh options
+	pressKeys(m, "esc")
+	if !m.showAddDialog || m.addDialog.options != nil {
+		t.Fatal("esc should go back to picking the torrent")
+	}
+	pressKeys(m, "enter")
+
+	runCmd(m, pressKeys(m, "enter"))
+	if len(mock.AddOptions) != 1 || !reflect.DeepEqual(mock.AddOptions[0], api.AddTorrentOptions{}) {
+		t.Errorf("untouched options should keep the server defaults, got %+v", mock.AddOptions)
+	}
+}
+
+func TestAddOptionsInvalidLimit(t *testing.T) {
+	m, mock := newAddOptionsTestView(t)
+
+	focusAddField(m, AddFieldSeedingTime)
+	pressKeys(m, "x")
+	runCmd(m, pressKeys(m, "enter"))
+	if m.lastError == nil || m.addDialog.options == nil || len(mock.AddedURLs) != 0 {
+		t.Error("an invalid limit should be reported without adding the torrent")
+	}
+}
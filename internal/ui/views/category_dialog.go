@@ -0,0 +1,403 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// CategoryDialogMode represents the current screen of the category dialog
+type CategoryDialogMode int
+
+const (
+	CategoryModeList CategoryDialogMode = iota
+	CategoryModeEdit
+	CategoryModeConfirmDelete
+)
+
+// CategoryField identifies the focused field in the category edit form
+type CategoryField int
+
+const (
+	CategoryFieldName CategoryField = iota
+	CategoryFieldSavePath
+)
+
+// CategoryDialog represents the category assignment and management dialog state
+type CategoryDialog struct {
+	mode         CategoryDialogMode
+	selectedIdx  int      // Index into the list; 0 is "(no category)"
+	targetHashes []string // Torrents the chosen category is assigned to
+	targetName   string   // Torrent name, or "N torrents" for bulk assignment
+
+	// Edit form state
+	isNew         bool // Creating a new category rather than editing one
+	focusedField  CategoryField
+	nameInput     string
+	savePathInput string
+}
+
+// NewCategoryDialog creates a new category dialog for the given torrents
+func NewCategoryDialog(targetHashes []string, targetName string) *CategoryDialog {
+	return &CategoryDialog{
+		mode:         CategoryModeList,
+		targetHashes: targetHashes,
+		targetName:   targetName,
+	}
+}
+
+// pasteText appends pasted text to the focused edit field
+func (d *CategoryDialog) pasteText(text string) {
+	if d.focusedField == CategoryFieldName {
+		d.nameInput = appendPrintable(d.nameInput, text)
+	} else {
+		d.savePathInput = appendPrintable(d.savePathInput, text)
+	}
+}
+
+// categoryEntries returns the dialog list: "" (no category) followed by all
+// category names in alphabetical order
+func (m *MainView) categoryEntries() []string {
+	return append([]string{""}, m.extractCategoryNames()...)
+}
+
+// handleCategoryDialog opens the category dialog for the marked or selected torrents
+func (m *MainView) handleCategoryDialog() {
+	hashes := m.getActionTorrentHashes()
+	m.categoryDialog = NewCategoryDialog(hashes, m.describeTorrents(hashes))
+
+	// Preselect the current category when all targets share one
+	if len(hashes) > 0 {
+		current := m.torrentMap[hashes[0]].Category
+		shared := true
+		for _, hash := range hashes[1:] {
+			if m.torrentMap[hash].Category != current {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			for i, name := range m.categoryEntries() {
+				if name == current {
+					m.categoryDialog.selectedIdx = i
+					break
+				}
+			}
+		}
+	}
+
+	m.showCategoryDialog = true
+}
+
+// closeCategoryDialog closes the category dialog and clears its state
+func (m *MainView) closeCategoryDialog() {
+	m.showCategoryDialog = false
+	m.categoryDialog = nil
+}
+
+// handleCategoryDialogKeys handles keyboard input for the category dialog
+func (m *MainView) handleCategoryDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.categoryDialog
+	entries := m.categoryEntries()
+	if d.selectedIdx >= len(entries) {
+		d.selectedIdx = len(entries) - 1
+	}
+
+	switch d.mode {
+	case CategoryModeList:
+		switch keyMsg.String() {
+		case "esc":
+			m.closeCategoryDialog()
+		case "up", "k":
+			if d.selectedIdx > 0 {
+				d.selectedIdx--
+			}
+		case "down", "j":
+			if d.selectedIdx < len(entries)-1 {
+				d.selectedIdx++
+			}
+		case "enter":
+			return m.confirmSetCategory(entries[d.selectedIdx])
+		case "n":
+			d.mode = CategoryModeEdit
+			d.isNew = true
+			d.focusedField = CategoryFieldName
+			d.nameInput = ""
+			d.savePathInput = ""
+		case "e":
+			name := entries[d.selectedIdx]
+			if name == "" {
+				return nil
+			}
+			d.mode = CategoryModeEdit
+			d.isNew = false
+			d.focusedField = CategoryFieldSavePath
+			d.nameInput = name
+			d.savePathInput = m.categories[name].SavePath
+		case "d":
+			if entries[d.selectedIdx] != "" {
+				d.mode = CategoryModeConfirmDelete
+			}
+		}
+
+	case CategoryModeEdit:
+		switch keyMsg.String() {
+		case "esc":
+			d.mode = CategoryModeList
+		case "tab", "shift+tab":
+			// The name of an existing category cannot be changed
+			if d.isNew {
+				if d.focusedField == CategoryFieldName {
+					d.focusedField = CategoryFieldSavePath
+				} else {
+					d.focusedField = CategoryFieldName
+				}
+			}
+		case "enter":
+			return m.saveCategory()
+		case "backspace":
+			if d.focusedField == CategoryFieldName {
+				d.nameInput = deleteLastRune(d.nameInput)
+			} else {
+				d.savePathInput = deleteLastRune(d.savePathInput)
+			}
+		case "ctrl+u":
+			if d.focusedField == CategoryFieldName {
+				d.nameInput = ""
+			} else {
+				d.savePathInput = ""
+			}
+		default:
+			if len(keyMsg.Text) > 0 {
+				d.pasteText(keyMsg.Text)
+			}
+		}
+
+	case CategoryModeConfirmDelete:
+		switch keyMsg.String() {
+		case "y", "Y", "enter":
+			d.mode = CategoryModeList
+			return m.removeCategory(entries[d.selectedIdx])
+		case "n", "N", "esc":
+			d.mode = CategoryModeList
+		}
+	}
+
+	return nil
+}
+
+// validateCategoryName checks a category name against qBittorrent's rules
+func validateCategoryName(name string) error {
+	if name == "" {
+		return fmt.Errorf("category name cannot be empty")
+	}
+	if strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/") || strings.Contains(name, "//") {
+		return fmt.Errorf("invalid category name: %s", name)
+	}
+	return nil
+}
+
+// saveCategory creates or edits the category in the edit form
+func (m *MainView) saveCategory() tea.Cmd {
+	d := m.categoryDialog
+	name := strings.TrimSpace(d.nameInput)
+	savePath := strings.TrimSpace(d.savePathInput)
+
+	if err := validateCategoryName(name); err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+	if _, exists := m.categories[name]; d.isNew && exists {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("category already exists: %s", name))
+		}
+	}
+
+	isNew := d.isNew
+	d.mode = CategoryModeList
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		if isNew {
+			if err := m.apiClient.CreateCategory(ctx, name, savePath); err != nil {
+				return errorMsg(fmt.Errorf("failed to create category: %w", err))
+			}
+			return successMsg(fmt.Sprintf("created category: %s", name))
+		}
+		if err := m.apiClient.EditCategory(ctx, name, savePath); err != nil {
+			return errorMsg(fmt.Errorf("failed to edit category: %w", err))
+		}
+		return successMsg(fmt.Sprintf("updated category: %s", name))
+	}
+}
+
+// removeCategory deletes a category from the server
+func (m *MainView) removeCategory(name string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := m.apiClient.RemoveCategories(ctx, []string{name}); err != nil {
+			return errorMsg(fmt.Errorf("failed to remove category: %w", err))
+		}
+		return successMsg(fmt.Sprintf("removed category: %s", name))
+	}
+}
+
+// confirmSetCategory assigns the category to the dialog's target torrents
+func (m *MainView) confirmSetCategory(category string) tea.Cmd {
+	d := m.categoryDialog
+	if len(d.targetHashes) == 0 {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("no torrent selected"))
+		}
+	}
+
+	hashes := d.targetHashes
+	target := d.targetName
+	m.closeCategoryDialog()
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := m.apiClient.SetCategory(ctx, hashes, category); err != nil {
+			return errorMsg(fmt.Errorf("failed to set category: %w", err))
+		}
+		if category == "" {
+			return successMsg(fmt.Sprintf("cleared category: %s", target))
+		}
+		return successMsg(fmt.Sprintf("%s -> %s", styles.TruncateString(target, 30), category))
+	}
+}
+
+// renderCategoryDialog renders the category dialog
+func (m *MainView) renderCategoryDialog() string {
+	d := m.categoryDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	title := styles.AccentStyle.Render("Category")
+	target := styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(d.targetName, 50)))
+	if len(d.targetHashes) == 0 {
+		target = styles.DimStyle.Render("No torrent selected")
+	}
+
+	var content, instructions string
+	switch d.mode {
+	case CategoryModeEdit:
+		content = m.renderCategoryForm()
+		instructions = "Enter: Save  Esc: Back"
+		if d.isNew {
+			instructions = "Tab: Switch field  " + instructions
+		}
+	case CategoryModeConfirmDelete:
+		entries := m.categoryEntries()
+		content = lipgloss.JoinVertical(lipgloss.Center,
+			"",
+			fmt.Sprintf("Remove category %s?", styles.ErrorStyle.Render(entries[d.selectedIdx])),
+			styles.DimStyle.Render("Torrents in this category will become uncategorized"),
+			"",
+		)
+		instructions = "Y/Enter: Confirm  N/Esc: Cancel"
+	default:
+		content = m.renderCategoryList()
+		instructions = "Enter: Assign  n: New  e: Edit  d: Delete  Esc: Close"
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		title,
+		target,
+		"",
+		content,
+		"",
+		styles.DimStyle.Render(instructions),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
+
+// renderCategoryList renders the selectable list of categories
+func (m *MainView) renderCategoryList() string {
+	d := m.categoryDialog
+	entries := m.categoryEntries()
+
+	var lines []string
+	maxLines := 12 // Limit displayed categories
+
+	// Calculate visible range (simple scrolling)
+	startIdx := 0
+	if d.selectedIdx >= maxLines {
+		startIdx = d.selectedIdx - maxLines + 1
+	}
+
+	for i := startIdx; i < len(entries) && i < startIdx+maxLines; i++ {
+		name := entries[i]
+		var line string
+		if name == "" {
+			line = fmt.Sprintf("%-24s", "(no category)")
+		} else {
+			savePath := m.categories[name].SavePath
+			if savePath == "" {
+				savePath = "default save path"
+			}
+			line = fmt.Sprintf("%-24s %s",
+				styles.TruncateString(name, 24),
+				styles.TruncateString(savePath, 34))
+		}
+
+		if i == d.selectedIdx {
+			line = styles.SelectedRowStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(entries) == 1 {
+		lines = append(lines, styles.DimStyle.Render("No categories yet - press n to create one"))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		strings.Repeat("─", 60),
+		strings.Join(lines, "\n"),
+		strings.Repeat("─", 60),
+	)
+}
+
+// renderCategoryForm renders the create/edit category form
+func (m *MainView) renderCategoryForm() string {
+	d := m.categoryDialog
+
+	renderField := func(label, value, placeholder string, focused bool) string {
+		inputStyle := styles.InputStyle.Width(60)
+		display := styles.DimStyle.Render(placeholder)
+		if value != "" {
+			display = styles.TextStyle.Render(value)
+		}
+		if focused {
+			inputStyle = styles.FocusedInputStyle.Width(60)
+			display = styles.TextStyle.Render(value + "▊")
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, label, inputStyle.Render(display))
+	}
+
+	heading := "Edit Category"
+	if d.isNew {
+		heading = "New Category"
+	}
+
+	fields := []string{
+		styles.SubtitleStyle.Render(heading),
+		"",
+		renderField("Name:", d.nameInput, "e.g. movies or tv/shows", d.isNew && d.focusedField == CategoryFieldName),
+		renderField("Save path:", d.savePathInput, "leave empty for default save path", d.focusedField == CategoryFieldSavePath),
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, fields...)
+}
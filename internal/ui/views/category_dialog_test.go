@@ -0,0 +1,21 @@
+package views
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestCategoryDialogBackspaceKeepsUTF8(t *testing.T) {
+	m := newTestMainView()
+	m.categories = map[string]api.Category{"Séries": {Name: "Séries", SavePath: "/données/séries"}}
+	m.handleCategoryDialog()
+
+	// Edit the category, then delete back into the accented characters
+	pressKeys(m, "down", "e", "backspace", "backspace", "backspace", "backspace")
+	d := m.categoryDialog
+	if d.savePathInput != "/données/sé" || !utf8.ValidString(d.savePathInput) {
+		t.Errorf("backspace should remove whole characters, got %q", d.savePathInput)
+	}
+}
@@ -0,0 +1,110 @@
+package views
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// ConnectionState describes how reachable the server is
+type ConnectionState int
+
+const (
+	ConnectionConnected    ConnectionState = iota
+	ConnectionDegraded                     // Recent refreshes failed, retrying at the normal interval
+	ConnectionOffline                      // Refreshes keep failing, retrying with backoff
+	ConnectionReconnecting                 // A retry is in flight while offline
+)
+
+const (
+	// connectionOfflineAfter consecutive failed refreshes mark the server offline
+	connectionOfflineAfter = 3
+	// connectionMaxBackoff caps the delay between retries while offline
+	connectionMaxBackoff = time.Minute
+)
+
+// syncErrorMsg reports a failed sync refresh, which drives the connection
+// state instead of the status line
+type syncErrorMsg struct {
+	err error
+}
+
+// Connection tracks the connection state from the outcome of sync refreshes
+type Connection struct {
+	state     ConnectionState
+	failures  int   // Consecutive failed refreshes
+	lastErr   error // Error of the last failed refresh
+	nextRetry time.Time
+}
+
+// isConnectionError reports whether err means the server couldn't be
+// reached or didn't answer properly, as opposed to e.g. rejected credentials
+func isConnectionError(err error) bool {
+	return api.IsNetworkError(err) || api.IsTimeoutError(err) || api.IsServerError(err)
+}
+
+// recordSuccess marks the server connected, reporting whether it recovered
+// from being offline, long enough for the server to have restarted
+func (c *Connection) recordSuccess() bool {
+	recovered := c.state == ConnectionOffline || c.state == ConnectionReconnecting
+	*c = Connection{}
+	return recovered
+}
+
+// recordFailure counts a failed refresh
+func (c *Connection) recordFailure(err error) {
+	c.failures++
+	c.lastErr = err
+	if c.failures >= connectionOfflineAfter {
+		c.state = ConnectionOffline
+	} else {
+		c.state = ConnectionDegraded
+	}
+}
+
+// retryDelay returns the delay until the next refresh: the refresh interval,
+// doubled for every failure past going offline, up to connectionMaxBackoff
+func (c *Connection) retryDelay(interval time.Duration) time.Duration {
+	if c.state != ConnectionOffline && c.state != ConnectionReconnecting {
+		return interval
+	}
+	delay := interval
+	for range c.failures - connectionOfflineAfter + 1 {
+		delay *= 2
+		if delay >= connectionMaxBackoff {
+			return max(connectionMaxBackoff, interval)
+		}
+	}
+	return delay
+}
+
+// renderBanner renders the connection banner, empty while connected
+func (c *Connection) renderBanner(lastData time.Time, width int) string {
+	var status string
+	style := styles.WarningStyle
+	switch c.state {
+	case ConnectionConnected:
+		return ""
+	case ConnectionDegraded:
+		status = "⚠ Connection problems, retrying"
+	case ConnectionOffline:
+		retry := max(time.Until(c.nextRetry).Round(time.Second), 0)
+		status = fmt.Sprintf("✗ Offline, retrying in %s", retry)
+		style = styles.ErrorStyle
+	case ConnectionReconnecting:
+		status = "⟳ Reconnecting..."
+	}
+
+	age := "no data received yet"
+	if !lastData.IsZero() {
+		age = fmt.Sprintf("last data %d seconds ago", int(time.Since(lastData).Seconds()))
+	}
+
+	banner := status + " — " + age
+	if c.lastErr != nil {
+		banner += fmt.Sprintf(" (%v)", c.lastErr)
+	}
+	return style.Render(styles.TruncateString(banner, width))
+}
@@ -0,0 +1,97 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestConnectionBacksOffAndResyncs(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{{Hash: "hash1", Name: "alpha"}}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.config.UI.RefreshInterval = 3
+	m.width, m.height = 120, 30
+	interval := 3 * time.Second
+
+	runCmd(m, m.fetchAllData())
+	if m.connection.state != ConnectionConnected || len(m.allTorrents) != 1 {
+		t.Fatal("first sync should connect")
+	}
+
+	// A single failure keeps syncing incrementally once the server answers
+	rid := m.currentRID
+	mock.GetError = api.NewNetworkError("request failed", nil)
+	runCmd(m, m.fetchAllData())
+	mock.GetError = nil
+	runCmd(m, m.fetchAllData())
+	if m.connection.state != ConnectionConnected || m.currentRID <= rid {
+		t.Fatalf("recovering from degraded should not resync, state %d rid %d", m.connection.state, m.currentRID)
+	}
+
+	// Failed refreshes degrade, then go offline and back off
+	mock.GetError = api.NewNetworkError("request failed", nil)
+	runCmd(m, m.fetchAllData())
+	if m.connection.state != ConnectionDegraded || m.lastError != nil {
+		t.Fatalf("one failure should degrade without an error in the status line, state %d", m.connection.state)
+	}
+	if !strings.Contains(m.renderMainView(), "last data 0 seconds ago") {
+		t.Error("banner should show the age of the data")
+	}
+	runCmd(m, m.fetchAllData())
+	runCmd(m, m.fetchAllData())
+	if m.connection.state != ConnectionOffline {
+		t.Fatalf("repeated failures should go offline, state %d", m.connection.state)
+	}
+	if delay := m.connection.retryDelay(interval); delay != 2*interval {
+		t.Errorf("offline retry delay should double, got %s", delay)
+	}
+	for range 10 {
+		m.connection.recordFailure(mock.GetError)
+	}
+	if delay := m.connection.retryDelay(interval); delay != connectionMaxBackoff {
+		t.Errorf("retry delay should be capped, got %s", delay)
+	}
+
+	// A tick while offline retries once; stale ticks are ignored
+	staleTick := tickMsg{id: m.tickID}
+	m.Update(tickMsg{id: m.tickID})
+	if m.connection.state != ConnectionReconnecting {
+		t.Fatalf("tick should start reconnecting, state %d", m.connection.state)
+	}
+	m.Update(staleTick)
+	if m.connection.state != ConnectionReconnecting || m.tickID != staleTick.id+1 {
+		t.Error("stale tick should be ignored")
+	}
+	if !strings.Contains(m.renderMainView(), "Reconnecting") {
+		t.Error("banner should show reconnecting")
+	}
+
+	// Recovery resyncs from scratch at the normal interval
+	mock.GetError = nil
+	mock.Torrents = append(mock.Torrents, api.Torrent{Hash: "hash2", Name: "beta"})
+	runCmd(m, m.fetchAllData())
+	if m.connection.state != ConnectionConnected || m.currentRID != 0 {
+		t.Fatalf("recovery should request a full resync, state %d rid %d", m.connection.state, m.currentRID)
+	}
+	if delay := m.connection.retryDelay(interval); delay != interval {
+		t.Errorf("refresh interval should be back to normal, got %s", delay)
+	}
+	if strings.Contains(m.renderMainView(), "last data") {
+		t.Error("banner should be gone once connected")
+	}
+
+	// Errors other than connection failures go to the status line
+	mock.GetError = api.NewAuthError("authentication required (403 Forbidden)", nil)
+	for _, cmd := range runCmd(m, m.fetchAllData()) {
+		runCmd(m, cmd)
+	}
+	if m.connection.state != ConnectionConnected || !api.IsAuthError(m.lastError) {
+		t.Errorf("auth errors should be reported, not treated as offline: %v", m.lastError)
+	}
+}
@@ -0,0 +1,251 @@
+package views
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// LimitsField identifies the focused field in the limits dialog
+type LimitsField int
+
+const (
+	LimitsFieldDownload LimitsField = iota
+	LimitsFieldUpload
+)
+
+// LimitsDialog represents the speed limits dialog state. It edits either the
+// limits of the target torrents or the global limits.
+type LimitsDialog struct {
+	global       bool
+	focusedField LimitsField
+	targetHashes []string
+	targetName   string
+
+	downloadInput string
+	uploadInput   string
+
+	// Prefilled values, so untouched fields are not re-sent with rounding
+	initialDownload string
+	initialUpload   string
+}
+
+// limitInputValue formats a limit for the dialog's input fields
+func limitInputValue(limit int64) string {
+	if limit <= 0 {
+		return ""
+	}
+	return styles.FormatSpeed(limit)
+}
+
+// handleLimitsDialog opens the limits dialog for the marked or selected torrents
+func (m *MainView) handleLimitsDialog() {
+	hashes := m.getActionTorrentHashes()
+	m.limitsDialog = &LimitsDialog{
+		targetHashes: hashes,
+		targetName:   m.describeTorrents(hashes),
+	}
+	// Without a target torrent only the global limits can be edited
+	m.setLimitsScope(len(hashes) == 0)
+	m.showLimitsDialog = true
+}
+
+// setLimitsScope switches between torrent and global limits, prefilling the
+// current values (blank when the torrents' limits differ)
+func (m *MainView) setLimitsScope(global bool) {
+	d := m.limitsDialog
+	d.global = global
+	d.downloadInput, d.uploadInput = "", ""
+
+	if global {
+		if m.stats != nil {
+			d.downloadInput = limitInputValue(m.stats.DlRateLimit)
+			d.uploadInput = limitInputValue(m.stats.UpRateLimit)
+		}
+	} else if len(d.targetHashes) > 0 {
+		first := m.torrentMap[d.targetHashes[0]]
+		sameDl, sameUp := true, true
+		for _, hash := range d.targetHashes[1:] {
+			torrent := m.torrentMap[hash]
+			sameDl = sameDl && torrent.DlLimit == first.DlLimit
+			sameUp = sameUp && torrent.UpLimit == first.UpLimit
+		}
+		if sameDl {
+			d.downloadInput = limitInputValue(first.DlLimit)
+		}
+		if sameUp {
+			d.uploadInput = limitInputValue(first.UpLimit)
+		}
+	}
+
+	d.initialDownload, d.initialUpload = d.downloadInput, d.uploadInput
+}
+
+// closeLimitsDialog closes the limits dialog and clears its state
+func (m *MainView) closeLimitsDialog() {
+	m.showLimitsDialog = false
+	m.limitsDialog = nil
+}
+
+// focusedLimitInput returns the input string of the focused field
+func (d *LimitsDialog) focusedLimitInput() *string {
+	if d.focusedField == LimitsFieldUpload {
+		return &d.uploadInput
+	}
+	return &d.downloadInput
+}
+
+// handleLimitsDialogKeys handles keyboard input for the limits dialog
+func (m *MainView) handleLimitsDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.limitsDialog
+	input := d.focusedLimitInput()
+
+	switch keyMsg.String() {
+	case "esc":
+		m.closeLimitsDialog()
+	case "tab", "shift+tab", "up", "down":
+		if d.focusedField == LimitsFieldDownload {
+			d.focusedField = LimitsFieldUpload
+		} else {
+			d.focusedField = LimitsFieldDownload
+		}
+	case "ctrl+g":
+		if len(d.targetHashes) > 0 {
+			m.setLimitsScope(!d.global)
+		}
+	case "enter":
+		return m.applyLimits()
+	case "backspace":
+		*input = deleteLastRune(*input)
+	case "ctrl+u":
+		*input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			*input = appendPrintable(*input, keyMsg.Text)
+		}
+	}
+
+	return nil
+}
+
+// applyLimits parses the changed fields and sends them to the server
+func (m *MainView) applyLimits() tea.Cmd {
+	d := m.limitsDialog
+
+	dlLimit, err := styles.ParseSpeedLimit(d.downloadInput)
+	if err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+	upLimit, err := styles.ParseSpeedLimit(d.uploadInput)
+	if err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+
+	setDl := d.downloadInput != d.initialDownload
+	setUp := d.uploadInput != d.initialUpload
+	global, hashes, target := d.global, d.targetHashes, d.targetName
+	m.closeLimitsDialog()
+	if !setDl && !setUp {
+		return nil
+	}
+
+	return m.setSpeedLimits(global, hashes, target, setDl, dlLimit, setUp, upLimit)
+}
+
+// setSpeedLimits updates the download and/or upload limit of torrents or globally
+func (m *MainView) setSpeedLimits(global bool, hashes []string, target string, setDl bool, dlLimit int64, setUp bool, upLimit int64) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		var err error
+		if setDl {
+			if global {
+				err = m.apiClient.SetGlobalDownloadLimit(ctx, dlLimit)
+			} else {
+				err = m.apiClient.SetTorrentDownloadLimit(ctx, hashes, dlLimit)
+			}
+			if err != nil {
+				return errorMsg(fmt.Errorf("failed to set download limit: %w", err))
+			}
+		}
+		if setUp {
+			if global {
+				err = m.apiClient.SetGlobalUploadLimit(ctx, upLimit)
+			} else {
+				err = m.apiClient.SetTorrentUploadLimit(ctx, hashes, upLimit)
+			}
+			if err != nil {
+				return errorMsg(fmt.Errorf("failed to set upload limit: %w", err))
+			}
+		}
+
+		if global {
+			target = "global"
+		}
+		return successMsg(fmt.Sprintf("speed limits updated: %s", target))
+	}
+}
+
+// renderLimitsDialog renders the speed limits dialog
+func (m *MainView) renderLimitsDialog() string {
+	d := m.limitsDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	title := styles.AccentStyle.Render("Speed Limits")
+	target := styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(d.targetName, 50)))
+	if d.global {
+		target = styles.DimStyle.Render("Global limits (all torrents)")
+	}
+
+	renderField := func(label, value string, focused bool) string {
+		inputStyle := styles.InputStyle.Width(60)
+		display := styles.DimStyle.Render("unlimited")
+		if value != "" {
+			display = styles.TextStyle.Render(value)
+		}
+		if focused {
+			inputStyle = styles.FocusedInputStyle.Width(60)
+			display = styles.TextStyle.Render(value + "▊")
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, label, inputStyle.Render(display))
+	}
+
+	fields := lipgloss.JoinVertical(lipgloss.Left,
+		renderField("Download limit:", d.downloadInput, d.focusedField == LimitsFieldDownload),
+		renderField("Upload limit:", d.uploadInput, d.focusedField == LimitsFieldUpload),
+	)
+
+	instructions := "Tab: Switch field  Enter: Apply  Esc: Cancel"
+	if len(d.targetHashes) > 0 {
+		if d.global {
+			instructions = "Ctrl+G: Torrent limits  " + instructions
+		} else {
+			instructions = "Ctrl+G: Global limits  " + instructions
+		}
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		title,
+		target,
+		"",
+		fields,
+		styles.DimStyle.Render("e.g. 500K, 2.5M, 1G - empty for unlimited"),
+		"",
+		styles.DimStyle.Render(instructions),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
@@ -0,0 +1,56 @@
+package views
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestLimitsDialogAppliesChangedFields(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{{Hash: "hash1", Name: "test", DlLimit: 1536000, UpLimit: 0}}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.torrentMap["hash1"] = mock.Torrents[0]
+	m.limitsDialog = &LimitsDialog{targetHashes: []string{"hash1"}, targetName: "test"}
+	m.setLimitsScope(false)
+	m.showLimitsDialog = true
+
+	// Only the upload field is edited; the rounded download prefill is kept
+	m.limitsDialog.focusedField = LimitsFieldUpload
+	m.Update(tea.PasteMsg{Content: "500K"})
+	cmd := m.applyLimits()
+	if cmd == nil {
+		t.Fatal("expected a command to set limits")
+	}
+	if msg, ok := cmd().(successMsg); !ok {
+		t.Fatalf("expected success, got %#v", msg)
+	}
+
+	if mock.Torrents[0].UpLimit != 500*1024 {
+		t.Errorf("upload limit: got %d, want %d", mock.Torrents[0].UpLimit, 500*1024)
+	}
+	if mock.Torrents[0].DlLimit != 1536000 {
+		t.Errorf("untouched download limit should not change, got %d", mock.Torrents[0].DlLimit)
+	}
+	if m.showLimitsDialog {
+		t.Error("dialog should close after applying")
+	}
+}
+
+func TestLimitsDialogRejectsInvalidInput(t *testing.T) {
+	m := newTestMainView()
+	m.limitsDialog = &LimitsDialog{global: true, downloadInput: "fast"}
+	m.showLimitsDialog = true
+
+	cmd := m.applyLimits()
+	if _, ok := cmd().(errorMsg); !ok {
+		t.Error("invalid limit should produce an error")
+	}
+	if !m.showLimitsDialog {
+		t.Error("dialog should stay open on invalid input")
+	}
+}
@@ -0,0 +1,391 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// logMaxEntries caps how many entries of each log are kept; the oldest
+// are dropped first
+const logMaxEntries = 5000
+
+// logTimeFormat formats log timestamps
+const logTimeFormat = "2006-01-02 15:04:05"
+
+// logEntriesMsg carries log entries logged after the ones already shown
+type logEntriesMsg struct {
+	messages []api.LogMessage
+	peers    []api.PeerLogEntry
+}
+
+// logTab is a tab of the log view
+type logTab int
+
+const (
+	logTabMain logTab = iota
+	logTabPeers
+)
+
+// LogView holds the server log view state
+type LogView struct {
+	tab logTab
+
+	messages      []api.LogMessage
+	peers         []api.PeerLogEntry
+	lastMessageID int // -1 before the first fetch
+	lastPeerID    int
+	loaded        bool
+
+	severities   api.LogType // Main log severities shown
+	query        string
+	editingQuery bool
+
+	follow bool // Keep the cursor on the newest entry
+	cursor int  // Index into the shown entries
+}
+
+// newLogView starts following the main log with every severity shown
+func newLogView() *LogView {
+	return &LogView{
+		lastMessageID: -1,
+		lastPeerID:    -1,
+		severities:    api.LogAll,
+		follow:        true,
+	}
+}
+
+// logLine is a log entry as shown in the view
+type logLine struct {
+	level string
+	style lipgloss.Style
+	text  string
+}
+
+// showsMessage reports whether a main log message passes the filters
+func (v *LogView) showsMessage(message api.LogMessage) bool {
+	return message.Type&v.severities != 0 && v.matchesQuery(message.Message)
+}
+
+// showsPeer reports whether a peer log entry passes the filter
+func (v *LogView) showsPeer(entry api.PeerLogEntry) bool {
+	return v.matchesQuery(entry.IP + " " + entry.Reason)
+}
+
+// matchesQuery reports whether text contains the query, ignoring case
+func (v *LogView) matchesQuery(text string) bool {
+	return v.query == "" || strings.Contains(strings.ToLower(text), strings.ToLower(v.query))
+}
+
+// lines returns the current tab's entries that pass the filters, oldest first
+func (v *LogView) lines() []logLine {
+	var lines []logLine
+	if v.tab == logTabPeers {
+		for _, entry := range v.peers {
+			if !v.showsPeer(entry) {
+				continue
+			}
+			line := logLine{level: "banned", style: styles.ErrorStyle, text: entry.IP}
+			if entry.Blocked {
+				line.level, line.style = "blocked", styles.WarningStyle
+			}
+			if entry.Reason != "" {
+				line.text += " - " + entry.Reason
+			}
+			lines = append(lines, line.withTime(entry.Time().Format(logTimeFormat)))
+		}
+		return lines
+	}
+
+	for _, message := range v.messages {
+		if !v.showsMessage(message) {
+			continue
+		}
+		line := logLine{level: message.Type.String(), style: logTypeStyle(message.Type), text: message.Message}
+		lines = append(lines, line.withTime(message.Time().Format(logTimeFormat)))
+	}
+	return lines
+}
+
+// withTime prefixes the line with when it was logged
+func (l logLine) withTime(timestamp string) logLine {
+	l.text = fmt.Sprintf("%s  %-8s  %s", timestamp, l.level, l.text)
+	return l
+}
+
+// logTypeStyle returns the style main log messages of a severity are shown in
+func logTypeStyle(t api.LogType) lipgloss.Style {
+	switch t {
+	case api.LogInfo:
+		return styles.DimStyle
+	case api.LogWarning:
+		return styles.WarningStyle
+	case api.LogCritical:
+		return styles.ErrorStyle
+	}
+	return styles.TextStyle
+}
+
+// filtersChanged keeps the cursor in range after the shown entries change
+func (v *LogView) filtersChanged() {
+	n := len(v.lines())
+	if v.follow {
+		v.cursor = max(n-1, 0)
+	} else {
+		v.cursor = max(min(v.cursor, n-1), 0)
+	}
+}
+
+// addEntries appends entries newer than the ones already shown, dropping
+// the oldest past logMaxEntries
+func (v *LogView) addEntries(msg logEntriesMsg) {
+	shifted := 0 // Shown entries dropped before the cursor
+
+	for _, message := range msg.messages {
+		if message.ID > v.lastMessageID {
+			v.messages = append(v.messages, message)
+			v.lastMessageID = message.ID
+		}
+	}
+	if excess := len(v.messages) - logMaxEntries; excess > 0 {
+		if v.tab == logTabMain {
+			for _, message := range v.messages[:excess] {
+				if v.showsMessage(message) {
+					shifted++
+				}
+			}
+		}
+		v.messages = append([]api.LogMessage(nil), v.messages[excess:]...)
+	}
+
+	for _, entry := range msg.peers {
+		if entry.ID > v.lastPeerID {
+			v.peers = append(v.peers, entry)
+			v.lastPeerID = entry.ID
+		}
+	}
+	if excess := len(v.peers) - logMaxEntries; excess > 0 {
+		if v.tab == logTabPeers {
+			for _, entry := range v.peers[:excess] {
+				if v.showsPeer(entry) {
+					shifted++
+				}
+			}
+		}
+		v.peers = append([]api.PeerLogEntry(nil), v.peers[excess:]...)
+	}
+
+	v.loaded = true
+	v.cursor -= shifted
+	v.filtersChanged()
+}
+
+// handleOpenLog switches to the server log view and loads the logs
+func (m *MainView) handleOpenLog() tea.Cmd {
+	m.viewMode = ViewModeLog
+	m.serverLog = newLogView()
+	return m.fetchLog()
+}
+
+// fetchLog loads the log entries logged since the last fetch
+func (m *MainView) fetchLog() tea.Cmd {
+	if m.serverLog == nil {
+		return nil
+	}
+	lastMessageID, lastPeerID := m.serverLog.lastMessageID, m.serverLog.lastPeerID
+	return func() tea.Msg {
+		ctx := context.Background()
+		messages, err := m.apiClient.GetLog(ctx, api.LogAll, lastMessageID)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load server log: %w", err))
+		}
+		peers, err := m.apiClient.GetPeerLog(ctx, lastPeerID)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load peer log: %w", err))
+		}
+		return logEntriesMsg{messages: messages, peers: peers}
+	}
+}
+
+// handleLogKeys handles keyboard input in the server log view
+func (m *MainView) handleLogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.serverLog
+	if v.editingQuery {
+		switch keyMsg.String() {
+		case "enter":
+			v.editingQuery = false
+		case "esc":
+			v.editingQuery = false
+			v.query = ""
+		case "backspace":
+			v.query = deleteLastRune(v.query)
+		case "ctrl+u":
+			v.query = ""
+		default:
+			if len(keyMsg.Text) > 0 {
+				v.query = appendPrintable(v.query, keyMsg.Text)
+			}
+		}
+		v.filtersChanged()
+		return nil
+	}
+
+	n := len(v.lines())
+	switch keyMsg.String() {
+	case "esc":
+		m.viewMode = ViewModeMain
+		m.serverLog = nil
+	case "tab":
+		v.tab = 1 - v.tab
+		v.follow = true
+		v.filtersChanged()
+	case "/":
+		v.editingQuery = true
+	case "1", "2", "3", "4":
+		if v.tab == logTabMain {
+			v.severities ^= api.LogTypes[keyMsg.String()[0]-'1']
+			v.filtersChanged()
+		}
+	case "f":
+		v.follow = !v.follow
+		v.filtersChanged()
+	case "r":
+		return m.fetchLog()
+	case "up", "k":
+		if v.cursor > 0 {
+			v.cursor--
+			v.follow = false
+		}
+	case "down", "j":
+		if v.cursor < n-1 {
+			v.cursor++
+		}
+	case "pgup":
+		v.cursor = max(v.cursor-10, 0)
+		v.follow = false
+	case "pgdown":
+		v.cursor = max(min(v.cursor+10, n-1), 0)
+	case "g":
+		v.cursor = 0
+		v.follow = false
+	case "G":
+		v.follow = true
+		v.filtersChanged()
+	}
+	return nil
+}
+
+// renderLogView renders the server log view in place of the torrent list
+func (m *MainView) renderLogView() string {
+	helpHeight := strings.Count(m.help.View(m.keys), "\n") + 1
+	contentHeight := m.height - helpHeight - 1
+	innerWidth := max(m.width-6, 40) // Panel borders and padding
+
+	content := styles.DimStyle.Render("Loading...")
+	if v := m.serverLog; v != nil && v.loaded {
+		header := v.renderLogHeader()
+		bodyHeight := max(contentHeight-2-strings.Count(header, "\n")-2, 1)
+		content = lipgloss.JoinVertical(lipgloss.Left, header, "", v.renderLogLines(innerWidth, bodyHeight))
+	}
+	panel := styles.FocusedPanelStyle.Width(m.width).Height(contentHeight).Render(content)
+
+	// Status line at the bottom - priority: error (red) > success (green) > help
+	var statusView string
+	if m.lastError != nil {
+		statusView = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.lastError))
+	} else if m.lastSuccess != "" {
+		statusView = styles.SuccessStyle.Render(fmt.Sprintf("✓ %s", m.lastSuccess))
+	} else if m.serverLog != nil && m.serverLog.editingQuery {
+		statusView = styles.DimStyle.Render("Type to filter • Enter keep filter • Esc clear filter")
+	} else {
+		statusView = styles.DimStyle.Render("↑↓ select • Tab main/peer log • 1-4 toggle severity • / filter • f follow • r refresh • Esc back")
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, panel, statusView)
+}
+
+// renderLogHeader renders the tabs, filters and follow state
+func (v *LogView) renderLogHeader() string {
+	tabs := []string{"Main log", "Peer log"}
+	for i, tab := range tabs {
+		if logTab(i) == v.tab {
+			tabs[i] = styles.TitleStyle.Render("[" + tab + "]")
+		} else {
+			tabs[i] = styles.DimStyle.Render(" " + tab + " ")
+		}
+	}
+
+	var filters []string
+	if v.tab == logTabMain {
+		for i, t := range api.LogTypes {
+			mark := "✗"
+			if v.severities&t != 0 {
+				mark = "✓"
+			}
+			filters = append(filters, logTypeStyle(t).Render(fmt.Sprintf("%d %s %s", i+1, mark, t)))
+		}
+	}
+
+	query := v.query
+	if v.editingQuery {
+		query = styles.AccentStyle.Render(query + "▊")
+	} else if query == "" {
+		query = styles.DimStyle.Render("none")
+	}
+	filters = append(filters, styles.DimStyle.Render("Filter: ")+query)
+
+	follow := styles.DimStyle.Render("Follow: off")
+	if v.follow {
+		follow = styles.SuccessStyle.Render("Follow: on")
+	}
+	filters = append(filters, follow)
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		strings.Join(tabs, " "),
+		strings.Join(filters, "   "),
+	)
+}
+
+// renderLogLines renders the shown entries scrolled to the cursor, with the
+// selected entry in full below when it doesn't fit on its row
+func (v *LogView) renderLogLines(width, height int) string {
+	lines := v.lines()
+	if len(lines) == 0 {
+		if v.tab == logTabPeers {
+			return styles.DimStyle.Render("No blocked or banned peers")
+		}
+		return styles.DimStyle.Render("No messages")
+	}
+
+	var details string
+	if v.cursor < len(lines) && lipgloss.Width(lines[v.cursor].text) > width {
+		details = styles.TextStyle.Width(width).Render(lines[v.cursor].text)
+		height -= strings.Count(details, "\n") + 2
+	}
+
+	// Only style the entries that fit, the log can be long
+	indexes := make([]int, len(lines))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	var rows []string
+	for _, i := range scrollWindow(indexes, v.cursor, max(height, 1)) {
+		text := styles.TruncateString(lines[i].text, width)
+		if i == v.cursor {
+			rows = append(rows, listRow(text, true, true))
+		} else {
+			rows = append(rows, lines[i].style.Render(text))
+		}
+	}
+
+	body := strings.Join(rows, "\n")
+	if details != "" {
+		body = lipgloss.JoinVertical(lipgloss.Left, body, "", details)
+	}
+	return body
+}
@@ -0,0 +1,114 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func newLogTestView(t *testing.T) (*MainView, *api.MockClient) {
+	t.Helper()
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Log = []api.LogMessage{
+		{ID: 0, Message: "qBittorrent v5.1.0 started", Type: api.LogNormal},
+		{ID: 1, Message: "Trying to listen on 0.0.0.0:6881", Type: api.LogInfo},
+		{ID: 2, Message: "Tracker error: tracker.example.org unreachable", Type: api.LogWarning},
+		{ID: 3, Message: "File error: No space left on device", Type: api.LogCritical},
+	}
+	mock.PeerLog = []api.PeerLogEntry{
+		{ID: 0, IP: "10.0.0.1", Blocked: true, Reason: "IP filter"},
+		{ID: 1, IP: "10.0.0.2"},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.width, m.height = 120, 30
+	runCmd(m, m.handleOpenLog())
+	if m.viewMode != ViewModeLog || len(m.serverLog.messages) != 4 {
+		t.Fatal("log view should open with the log loaded")
+	}
+	return m, mock
+}
+
+func TestLogViewFiltersAndFollows(t *testing.T) {
+	m, mock := newLogTestView(t)
+	v := m.serverLog
+
+	if !v.follow || v.cursor != 3 {
+		t.Errorf("log should open following the newest message, cursor at %d", v.cursor)
+	}
+
+	// Hide normal and info messages
+	pressKeys(m, "1", "2")
+	lines := v.lines()
+	if len(lines) != 2 || !strings.Contains(lines[0].text, "warning") {
+		t.Fatalf("only warnings and critical messages should show, got %v", lines)
+	}
+
+	// Text search on top of the severity filter
+	pressKeys(m, "/")
+	m.Update(tea.PasteMsg{Content: "SPACE"})
+	pressKeys(m, "enter")
+	if lines := v.lines(); len(lines) != 1 || !strings.Contains(lines[0].text, "No space left") {
+		t.Errorf("search should match ignoring case, got %v", lines)
+	}
+	pressKeys(m, "/", "esc", "1", "2")
+	if len(v.lines()) != 4 {
+		t.Fatal("esc should clear the search")
+	}
+
+	// Moving up stops following; new messages don't move the cursor
+	pressKeys(m, "up")
+	mock.Log = append(mock.Log, api.LogMessage{ID: 4, Message: "Torrent added", Type: api.LogNormal})
+	runCmd(m, m.fetchLog())
+	if v.follow || v.cursor != 2 || len(v.messages) != 5 {
+		t.Errorf("new message should be added without moving the cursor, cursor at %d", v.cursor)
+	}
+
+	// Following again jumps to and sticks to the newest message
+	pressKeys(m, "f")
+	mock.Log = append(mock.Log, api.LogMessage{ID: 5, Message: "Torrent finished", Type: api.LogNormal})
+	runCmd(m, m.fetchLog())
+	runCmd(m, m.fetchLog())
+	if !v.follow || v.cursor != 5 || len(v.messages) != 6 {
+		t.Errorf("following should keep the cursor on the newest message, cursor at %d of %d", v.cursor, len(v.messages))
+	}
+
+	// The peer log is fetched alongside
+	pressKeys(m, "tab")
+	if lines := v.lines(); len(lines) != 2 || !strings.Contains(lines[0].text, "blocked") || !strings.Contains(lines[1].text, "banned") {
+		t.Errorf("peer log should show blocked and banned peers, got %v", lines)
+	}
+
+	pressKeys(m, "esc")
+	if m.viewMode != ViewModeMain || m.serverLog != nil {
+		t.Error("esc should return to the torrent list")
+	}
+}
+
+func TestLogViewDropsOldestEntries(t *testing.T) {
+	v := newLogView()
+	v.follow = false
+
+	var messages []api.LogMessage
+	for i := range logMaxEntries {
+		messages = append(messages, api.LogMessage{ID: i, Message: fmt.Sprintf("message %d", i), Type: api.LogNormal})
+	}
+	v.addEntries(logEntriesMsg{messages: messages})
+	v.cursor = 100
+
+	v.addEntries(logEntriesMsg{messages: []api.LogMessage{
+		{ID: logMaxEntries, Type: api.LogNormal},
+		{ID: logMaxEntries + 1, Type: api.LogNormal},
+	}})
+	if len(v.messages) != logMaxEntries || v.messages[0].ID != 2 {
+		t.Fatalf("oldest messages should be dropped, first is now %d", v.messages[0].ID)
+	}
+	if !strings.HasSuffix(v.lines()[v.cursor].text, "message 100") {
+		t.Errorf("cursor should stay on the same message, at %d", v.cursor)
+	}
+}
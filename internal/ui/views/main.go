@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
@@ -72,6 +73,7 @@ type AddTorrentDialog struct {
 	mode     AddMode
 	fileNav  *FileNavigator
 	urlInput *URLInput
+	options  *AddOptionsForm // Options step, nil while picking the torrent
 }
 
 // LocationMode represents the mode for setting location
@@ -103,16 +105,17 @@ type ViewMode int
 const (
 	ViewModeMain ViewMode = iota
 	ViewModeDetails
+	ViewModePreferences
+	ViewModeRSS
+	ViewModeSearch
+	ViewModeLog
 )
 
 // Message types
 type (
 	syncDataMsg         *api.SyncMainDataResponse
-	categoriesDataMsg   map[string]interface{}
-	tagsDataMsg         []string
 	errorMsg            error
 	successMsg          string
-	tickMsg             time.Time
 	uiTickMsg           time.Time // Separate tick for UI updates
 	clearErrorMsg       struct{}
 	clearSuccessMsg     struct{}
@@ -124,6 +127,13 @@ type (
 	}
 )
 
+// tickMsg triggers a periodic refresh; ticks from before the latest tickCmd
+// are ignored
+type tickMsg struct {
+	id   int
+	time time.Time
+}
+
 // MainView is the main application view
 type MainView struct {
 	config    *config.Config
@@ -142,7 +152,7 @@ type MainView struct {
 	torrentMap      map[string]api.Torrent // hash -> torrent for sync API
 	currentRID      int                    // Current RID for sync API incremental updates
 	stats           *api.GlobalStats
-	categories      map[string]interface{}
+	categories      map[string]api.Category
 	tags            []string
 	currentFilter   filter.Filter
 	viewMode        ViewMode
@@ -151,25 +161,71 @@ type MainView struct {
 	lastSuccess     string
 	isLoading       bool
 	lastRefreshTime time.Time // Track when data was last refreshed
+	connection      Connection
+	tickID          int // ID of the pending refresh tick
 
 	// Terminal title state
 	lastRenderedTitle string // Cache to avoid unnecessary terminal writes
 
 	// Delete confirmation dialog state
-	showDeleteDialog bool
-	deleteTargetHash string
-	deleteTargetName string
-	deleteWithFiles  bool
+	showDeleteDialog   bool
+	deleteTargetHashes []string
+	deleteTargetName   string // Torrent name, or "N torrents" for bulk deletes
+	deleteWithFiles    bool
 
 	// Add torrent dialog state
 	showAddDialog bool
 	addDialog     *AddTorrentDialog
 
 	// Location dialog state
-	showLocationDialog bool
-	locationDialog     *LocationDialog
-	locationTargetHash string
-	locationTargetName string
+	showLocationDialog   bool
+	locationDialog       *LocationDialog
+	locationTargetHashes []string
+	locationTargetName   string
+
+	// Category dialog state
+	showCategoryDialog bool
+	categoryDialog     *CategoryDialog
+
+	// Tag dialog state
+	showTagDialog bool
+	tagDialog     *TagDialog
+
+	// Rename dialog state
+	showRenameDialog bool
+	renameDialog     *RenameDialog
+
+	// Speed limits dialog state
+	showLimitsDialog bool
+	limitsDialog     *LimitsDialog
+
+	// Share limits dialog state
+	showShareLimitsDialog bool
+	shareLimitsDialog     *ShareLimitsDialog
+
+	// Tracker editor dialog and remove tracker confirmation state
+	showTrackerDialog       bool
+	trackerDialog           *TrackerDialog
+	showRemoveTrackerDialog bool
+	removeTrackerTarget     components.TrackerRemoveRequestMsg
+
+	// Add peers dialog and ban peer confirmation state
+	showPeerDialog    bool
+	peerDialog        *PeerDialog
+	showBanPeerDialog bool
+	banPeerTarget     components.PeerBanRequestMsg
+
+	// Preferences editor state, nil while loading
+	preferences *PreferencesEditor
+
+	// RSS view state, nil outside the RSS view
+	rss *RSSView
+
+	// Search view state, nil outside the search view
+	search *SearchView
+
+	// Server log view state, nil outside the log view
+	serverLog *LogView
 
 	// Dimensions
 	width  int
@@ -196,8 +252,35 @@ type KeyMap struct {
 	Resume      key.Binding
 	Delete      key.Binding
 	Add         key.Binding
+	Recheck     key.Binding
+	Reannounce  key.Binding
+	ForceStart  key.Binding
+	Sequential  key.Binding
+	FirstLast   key.Binding
+	QueueUp     key.Binding
+	QueueDown   key.Binding
+	QueueTop    key.Binding
+	QueueBottom key.Binding
 	SetLocation key.Binding
+	Category    key.Binding
+	Tags        key.Binding
+	Rename      key.Binding
+	Limits      key.Binding
+	ShareLimits key.Binding
+	Trackers    key.Binding
+	AddPeers    key.Binding
+	Preferences key.Binding
+	RSS         key.Binding
+	Search      key.Binding
+	Log         key.Binding
+	AltSpeed    key.Binding
 	Columns     key.Binding
+
+	// Selection
+	Mark            key.Binding
+	VisualSelect    key.Binding
+	SelectAll       key.Binding
+	InvertSelection key.Binding
 }
 
 // ShortHelp returns keybindings to be shown in the mini help view
@@ -208,10 +291,14 @@ func (k KeyMap) ShortHelp() []key.Binding {
 // FullHelp returns keybindings for the expanded help view
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Up, k.Down, k.Enter, k.Escape},               // Navigation and Actions
-		{k.Pause, k.Resume, k.Delete, k.Add},            // Torrent Control
-		{k.SetLocation, k.Refresh, k.Filter, k.Columns}, // Features
-		{k.Help, k.Quit},                                // General
+		{k.Up, k.Down, k.Enter, k.Escape, k.Refresh},                                   // Navigation and Actions
+		{k.Pause, k.Resume, k.Delete, k.Add, k.Recheck, k.Reannounce},                  // Torrent Control
+		{k.ForceStart, k.Sequential, k.FirstLast, k.Limits, k.ShareLimits, k.AltSpeed}, // Torrent Options
+		{k.QueueUp, k.QueueDown, k.QueueTop, k.QueueBottom},                            // Queue
+		{k.SetLocation, k.Category, k.Tags, k.Rename, k.Trackers, k.AddPeers},          // Organize
+		{k.Preferences, k.RSS, k.Search, k.Log, k.Filter, k.Columns},                   // Features
+		{k.Mark, k.VisualSelect, k.SelectAll, k.InvertSelection},                       // Selection
+		{k.Help, k.Quit}, // General
 	}
 }
 
@@ -269,13 +356,115 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("a"),
 			key.WithHelp("a", "add torrent"),
 		),
+		Recheck: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "force recheck"),
+		),
+		Reannounce: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "reannounce"),
+		),
+		ForceStart: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "force start"),
+		),
+		Sequential: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "sequential download"),
+		),
+		FirstLast: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "first/last piece"),
+		),
+		QueueUp: key.NewBinding(
+			key.WithKeys("+"),
+			key.WithHelp("+", "queue up"),
+		),
+		QueueDown: key.NewBinding(
+			key.WithKeys("-"),
+			key.WithHelp("-", "queue down"),
+		),
+		QueueTop: key.NewBinding(
+			key.WithKeys("ctrl+up"),
+			key.WithHelp("ctrl+↑", "queue top"),
+		),
+		QueueBottom: key.NewBinding(
+			key.WithKeys("ctrl+down"),
+			key.WithHelp("ctrl+↓", "queue bottom"),
+		),
 		SetLocation: key.NewBinding(
 			key.WithKeys("l"),
 			key.WithHelp("l", "set location"),
 		),
+		Category: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "category"),
+		),
+		Tags: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "tags"),
+		),
+		Rename: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "rename"),
+		),
+		Limits: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "speed limits"),
+		),
+		ShareLimits: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "share limits"),
+		),
+		Trackers: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "replace trackers"),
+		),
+		AddPeers: key.NewBinding(
+			key.WithKeys("ctrl+p"),
+			key.WithHelp("ctrl+p", "add peers"),
+		),
+		Preferences: key.NewBinding(
+			key.WithKeys(","),
+			key.WithHelp(",", "preferences"),
+		),
+		RSS: key.NewBinding(
+			key.WithKeys("ctrl+n"),
+			key.WithHelp("ctrl+n", "RSS feeds"),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("ctrl+f"),
+			key.WithHelp("ctrl+f", "search"),
+		),
+		Log: key.NewBinding(
+			key.WithKeys("ctrl+l"),
+			key.WithHelp("ctrl+l", "server log"),
+		),
+		AltSpeed: key.NewBinding(
+			key.WithKeys("z"),
+			key.WithHelp("z", "alt speed limits"),
+		),
 		Columns: key.NewBinding(
 			key.WithKeys("C"),
-			key.WithHelp("C", "configure columns"),
+			key.WithHelp("C", "columns"),
+		),
+
+		// Selection
+		Mark: key.NewBinding(
+			key.WithKeys("space", " "),
+			key.WithHelp("space", "mark/unmark"),
+		),
+		VisualSelect: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "visual select"),
+		),
+		SelectAll: key.NewBinding(
+			key.WithKeys("ctrl+a"),
+			key.WithHelp("ctrl+a", "mark all"),
+		),
+		InvertSelection: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "invert marks"),
 		),
 	}
 }
@@ -317,11 +506,8 @@ func (m *MainView) fetchAllData() tea.Cmd {
 		}
 	}
 
-	return tea.Batch(
-		m.fetchTorrents(), // Gets torrents + stats via sync API
-		m.fetchCategories(),
-		m.fetchTags(),
-	)
+	// Torrents, categories, tags and stats all come from the sync API
+	return m.fetchTorrents()
 }
 
 // fetchTorrents fetches torrent data using the sync API for incremental updates
@@ -334,40 +520,21 @@ func (m *MainView) fetchTorrents() tea.Cmd {
 
 		syncData, err := m.apiClient.SyncMainData(ctx, m.currentRID)
 		if err != nil {
-			return errorMsg(err)
+			return syncErrorMsg{err: err}
 		}
 		return syncDataMsg(syncData)
 	})
 }
 
-// fetchCategories fetches categories
-func (m *MainView) fetchCategories() tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		ctx := context.Background()
-		categories, err := m.apiClient.GetCategories(ctx)
-		if err != nil {
-			return errorMsg(err)
-		}
-		return categoriesDataMsg(categories)
-	})
-}
-
-// fetchTags fetches tags
-func (m *MainView) fetchTags() tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		ctx := context.Background()
-		tags, err := m.apiClient.GetTags(ctx)
-		if err != nil {
-			return errorMsg(err)
-		}
-		return tagsDataMsg(tags)
-	})
-}
-
-// tickCmd creates a periodic tick for refreshing data
+// tickCmd creates a periodic tick for refreshing data, backing off while
+// the server is offline. It replaces any pending tick.
 func (m *MainView) tickCmd() tea.Cmd {
-	return tea.Tick(time.Duration(m.config.UI.RefreshInterval)*time.Second, func(t time.Time) tea.Msg {
-		return tickMsg(t)
+	m.tickID++
+	id := m.tickID
+	delay := m.connection.retryDelay(time.Duration(m.config.UI.RefreshInterval) * time.Second)
+	m.connection.nextRetry = time.Now().Add(delay)
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
+		return tickMsg{id: id, time: t}
 	})
 }
 
@@ -426,7 +593,7 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if syncData.FullUpdate {
 			// Clear existing data and replace with new data
 			m.torrentMap = make(map[string]api.Torrent)
-			m.categories = make(map[string]interface{})
+			m.categories = make(map[string]api.Category)
 			m.tags = nil
 			for hash, partial := range syncData.Torrents {
 				torrent := partial.ToTorrent()
@@ -470,18 +637,18 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.isLoading = false
 		m.lastRefreshTime = time.Now()
 
-		// Update categories if provided (incremental: add/update new, remove deleted)
-		if syncData.Categories != nil && len(syncData.Categories) > 0 {
+		// Update categories if provided (incremental: add/update new, remove deleted).
+		// Categories and tags are listed even when no torrent uses them.
+		if len(syncData.Categories) > 0 {
 			if m.categories == nil {
-				m.categories = make(map[string]interface{})
+				m.categories = make(map[string]api.Category)
 			}
 			// Add or update categories
 			for name, cat := range syncData.Categories {
-				m.categories[name] = map[string]interface{}{
-					"name":          cat.Name,
-					"savePath":      cat.SavePath,
-					"download_path": cat.DownloadPath,
+				if cat.Name == "" {
+					cat.Name = name
 				}
+				m.categories[name] = cat
 			}
 		}
 		// Remove deleted categories
@@ -509,7 +676,8 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			for _, tag := range syncData.TagsRemoved {
 				removeSet[tag] = struct{}{}
 			}
-			filtered := m.tags[:0]
+			// Build a new slice, the filter panel and tag dialog share the old one
+			filtered := make([]string, 0, len(m.tags))
 			for _, tag := range m.tags {
 				if _, remove := removeSet[tag]; !remove {
 					filtered = append(filtered, tag)
@@ -518,6 +686,9 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.tags = filtered
 		}
 
+		// Offer the server's categories and tags in the filter panel
+		m.filterPanel.SetAvailableOptions(m.extractCategoryNames(), m.extractTrackerNames(), m.tags)
+
 		// Update stats from server state
 		if m.stats == nil {
 			m.stats = &api.GlobalStats{}
@@ -544,6 +715,15 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if syncData.ServerState.FreeSpaceOnDisk != nil {
 			m.stats.FreeSpaceOnDisk = *syncData.ServerState.FreeSpaceOnDisk
 		}
+		if syncData.ServerState.DlRateLimit != nil {
+			m.stats.DlRateLimit = *syncData.ServerState.DlRateLimit
+		}
+		if syncData.ServerState.UpRateLimit != nil {
+			m.stats.UpRateLimit = *syncData.ServerState.UpRateLimit
+		}
+		if syncData.ServerState.UseAltSpeedLimits != nil {
+			m.stats.UseAltSpeedLimits = *syncData.ServerState.UseAltSpeedLimits
+		}
 
 		// Update stats panel with latest stats
 		m.statsPanel.SetStats(m.stats)
@@ -563,22 +743,25 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update terminal title after torrent data received
 		m.updateTerminalTitle()
 
-	case categoriesDataMsg:
-		m.categories = map[string]interface{}(msg)
-		// Extract category names for filter panel
-		var categoryNames []string
-		for name := range m.categories {
-			categoryNames = append(categoryNames, name)
-		}
-		// Sort categories alphabetically for stable display order
-		sort.Strings(categoryNames)
-		m.filterPanel.SetAvailableOptions(categoryNames, m.extractTrackerNames(), m.tags)
-
-	case tagsDataMsg:
-		m.tags = []string(msg)
-		// Sort tags alphabetically for stable display order
-		sort.Strings(m.tags)
-		m.filterPanel.SetAvailableOptions(m.extractCategoryNames(), m.extractTrackerNames(), m.tags)
+		if m.connection.recordSuccess() {
+			// The server may have restarted while unreachable, so start over
+			// with a full update rather than trusting the old RID
+			if !syncData.FullUpdate {
+				m.currentRID = 0
+				cmds = append(cmds, m.fetchAllData())
+			}
+			// Back to the normal refresh interval
+			cmds = append(cmds, m.tickCmd())
+		}
+
+	case syncErrorMsg:
+		m.isLoading = false
+		if !isConnectionError(msg.err) {
+			cmds = append(cmds, func() tea.Msg { return errorMsg(msg.err) })
+			break
+		}
+		// Shown in the connection banner rather than the status line
+		m.connection.recordFailure(msg.err)
 
 	case errorMsg:
 		m.lastError = error(msg)
@@ -589,6 +772,53 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case clearErrorMsg:
 		m.lastError = nil
 
+	case preferencesMsg:
+		m.handlePreferencesLoaded(msg)
+
+	case preferencesSavedMsg:
+		cmds = append(cmds, m.fetchPreferences(), func() tea.Msg {
+			return successMsg(fmt.Sprintf("saved %d preference(s)", int(msg)))
+		})
+
+	case rssDataMsg:
+		cmds = append(cmds, m.handleRSSData(msg))
+
+	case rssMatchesMsg:
+		m.handleRSSMatches(msg)
+
+	case rssUpdatedMsg:
+		cmds = append(cmds, m.fetchRSS(), func() tea.Msg {
+			return successMsg(string(msg))
+		})
+
+	case rssRuleSavedMsg:
+		m.handleRSSRuleSaved(string(msg))
+		cmds = append(cmds, m.fetchRSS(), func() tea.Msg {
+			return successMsg(fmt.Sprintf("saved rule: %s", string(msg)))
+		})
+
+	case searchPluginsMsg:
+		m.handleSearchPlugins(msg)
+
+	case searchPluginsUpdatedMsg:
+		cmds = append(cmds, m.fetchSearchPlugins(), func() tea.Msg {
+			return successMsg(string(msg))
+		})
+
+	case searchStartedMsg:
+		cmds = append(cmds, m.handleSearchStarted(int(msg)))
+
+	case searchResultsMsg:
+		cmds = append(cmds, m.handleSearchResults(msg))
+
+	case searchPollMsg:
+		cmds = append(cmds, m.handleSearchPoll(int(msg)))
+
+	case logEntriesMsg:
+		if m.serverLog != nil {
+			m.serverLog.addEntries(msg)
+		}
+
 	case successMsg:
 		m.lastSuccess = string(msg)
 		// Clear any existing errors when showing success
@@ -600,8 +830,7 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, m.delayedRefresh())
 
 	case directoryContentMsg:
-		if m.locationDialog != nil && m.locationDialog.remoteNav != nil {
-			nav := m.locationDialog.remoteNav
+		if nav := m.activeRemoteNav(); nav != nil {
 			if msg.path != nav.currentPath {
 				break
 			}
@@ -633,24 +862,128 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.torrentDetails, cmd = m.torrentDetails.Update(msg)
 		cmds = append(cmds, cmd)
 
+	case components.RenameRequestMsg:
+		m.handleRenameRequest(msg)
+
+	case components.TrackerRequestMsg:
+		m.handleTrackerRequest(msg)
+
+	case components.TrackerRemoveRequestMsg:
+		m.handleRemoveTrackerRequest(msg)
+
+	case components.AddPeersRequestMsg:
+		m.handleAddPeersRequest(msg)
+
+	case components.PeerBanRequestMsg:
+		m.handleBanPeerRequest(msg)
+
+	case components.DetailsActionMsg:
+		// Let the details component reload, then report through the status line
+		m.torrentDetails, cmd = m.torrentDetails.Update(msg)
+		cmds = append(cmds, cmd)
+		if msg.Err != nil {
+			cmds = append(cmds, func() tea.Msg { return errorMsg(msg.Err) })
+		} else {
+			cmds = append(cmds, func() tea.Msg { return successMsg(msg.Success) })
+		}
+
 	case tickMsg:
-		// Refresh data periodically
-		cmds = append(cmds, m.fetchAllData(), m.tickCmd())
+		if msg.id != m.tickID {
+			break
+		}
+
+		// Refresh data periodically; while offline only one retry is in flight
+		switch m.connection.state {
+		case ConnectionOffline:
+			m.connection.state = ConnectionReconnecting
+			cmds = append(cmds, m.fetchAllData())
+		case ConnectionReconnecting:
+		default:
+			cmds = append(cmds, m.fetchAllData())
+		}
+		cmds = append(cmds, m.tickCmd())
+
+		// The view refreshes below would only fail while the server is away
+		if m.connection.state != ConnectionConnected {
+			break
+		}
 
 		// Also refresh torrent details if in details view
 		if m.viewMode == ViewModeDetails {
-			m.torrentDetails, cmd = m.torrentDetails.Update(time.Time(msg))
+			m.torrentDetails, cmd = m.torrentDetails.Update(msg.time)
 			cmds = append(cmds, cmd)
 		}
 
+		// Pick up new articles and feeds that finished refreshing
+		if m.viewMode == ViewModeRSS && time.Since(m.rss.fetchedAt) >= rssRefreshInterval {
+			cmds = append(cmds, m.fetchRSS())
+		}
+
+		// Pick up newly logged entries
+		if m.viewMode == ViewModeLog {
+			cmds = append(cmds, m.fetchLog())
+		}
+
 	case uiTickMsg:
 		// Just trigger a re-render for UI updates (like refresh timer)
 		// Continue the UI tick
 		cmds = append(cmds, m.uiTickCmd())
 
 	case tea.KeyPressMsg:
+		// The preferences view takes over the keyboard, like a dialog
+		if m.viewMode == ViewModePreferences {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			cmd = m.handlePreferencesKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			return m, tea.Batch(cmds...)
+		}
+		if m.viewMode == ViewModeRSS {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			cmd = m.handleRSSKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			return m, tea.Batch(cmds...)
+		}
+		if m.viewMode == ViewModeSearch {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			cmd = m.handleSearchKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			return m, tea.Batch(cmds...)
+		}
+		if m.viewMode == ViewModeLog {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			cmd = m.handleLogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			return m, tea.Batch(cmds...)
+		}
+
 		// Handle add torrent dialog first (highest priority)
 		if m.showAddDialog {
+			if m.addDialog.options != nil {
+				if msg.String() == "ctrl+c" {
+					return m, tea.Quit
+				}
+				cmd = m.handleAddOptionsKeys(msg)
+				if cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+				return m, tea.Batch(cmds...)
+			}
 			switch msg.String() {
 			case "esc":
 				// Close dialog
@@ -711,7 +1044,7 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						cmds = append(cmds, cmd)
 					}
 				} else {
-					cmd = m.handleLocationBrowserKeys(msg.String())
+					cmd = m.handleRemoteBrowserKeys(m.locationDialog.remoteNav, msg.String(), m.confirmSetLocation)
 					if cmd != nil {
 						cmds = append(cmds, cmd)
 					}
@@ -721,6 +1054,134 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// Handle category dialog
+		if m.showCategoryDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handleCategoryDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle tag dialog
+		if m.showTagDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handleTagDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle rename dialog
+		if m.showRenameDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handleRenameDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle speed limits dialog
+		if m.showLimitsDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handleLimitsDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle share limits dialog
+		if m.showShareLimitsDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handleShareLimitsDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle tracker dialog
+		if m.showTrackerDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handleTrackerDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle add peers dialog
+		if m.showPeerDialog {
+			if msg.String() == "ctrl+c" {
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			cmd = m.handlePeerDialogKeys(msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			// Return here to prevent any other key handling when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle remove tracker confirmation dialog
+		if m.showRemoveTrackerDialog {
+			switch msg.String() {
+			case "y", "Y", "enter":
+				cmds = append(cmds, m.confirmRemoveTracker())
+			case "n", "N", "esc":
+				m.cancelRemoveTracker()
+			case "ctrl+c":
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			// Ignore other keys when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
+		// Handle ban peer confirmation dialog
+		if m.showBanPeerDialog {
+			switch msg.String() {
+			case "y", "Y", "enter":
+				cmds = append(cmds, m.confirmBanPeer())
+			case "n", "N", "esc":
+				m.cancelBanPeer()
+			case "ctrl+c":
+				// Still allow quit even in dialog
+				return m, tea.Quit
+			}
+			// Ignore other keys when dialog is open
+			return m, tea.Batch(cmds...)
+		}
+
 		// Handle delete confirmation dialog second priority
 		if m.showDeleteDialog {
 			switch msg.String() {
@@ -797,6 +1258,13 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		// Tab-specific actions in the details view take precedence over global keys
+		if m.viewMode == ViewModeDetails && m.torrentDetails.HandlesKey(msg) {
+			m.torrentDetails, cmd = m.torrentDetails.Update(msg)
+			cmds = append(cmds, cmd)
+			return m, tea.Batch(cmds...)
+		}
+
 		switch {
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
@@ -805,6 +1273,9 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.viewMode == ViewModeDetails {
 				m.viewMode = ViewModeMain
 				m.detailsViewHash = "" // Clear details view tracking
+			} else if m.viewMode == ViewModeMain && m.torrentList.HasMarks() {
+				// First escape drops the marked set before falling back to the filter panel
+				m.torrentList.ClearMarks()
 			} else if m.viewMode == ViewModeMain {
 				// Let filter panel handle escape to exit search mode
 				// Note: column config mode escape is handled earlier in the key hierarchy
@@ -867,10 +1338,106 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.Add):
 			m.showAddDialog = true
 
+		case key.Matches(msg, m.keys.Recheck):
+			cmd = m.handleRecheckTorrent()
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.Reannounce):
+			cmd = m.handleReannounceTorrent()
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.ForceStart):
+			cmd = m.handleForceStartTorrent()
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.Sequential):
+			cmd = m.handleToggleSetting("sequential download",
+				func(t api.Torrent) bool { return t.SeqDownload }, m.apiClient.ToggleSequentialDownload)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.FirstLast):
+			cmd = m.handleToggleSetting("first/last piece priority",
+				func(t api.Torrent) bool { return t.FirstLastPiece }, m.apiClient.ToggleFirstLastPiecePrio)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.QueueUp):
+			cmd = m.handleQueueMove(m.apiClient.IncreasePriority, "moved up in queue")
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.QueueDown):
+			cmd = m.handleQueueMove(m.apiClient.DecreasePriority, "moved down in queue")
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.QueueTop):
+			cmd = m.handleQueueMove(m.apiClient.TopPriority, "moved to top of queue")
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, m.keys.QueueBottom):
+			cmd = m.handleQueueMove(m.apiClient.BottomPriority, "moved to bottom of queue")
+			cmds = append(cmds, cmd)
+
 		case key.Matches(msg, m.keys.SetLocation):
 			cmd = m.handleSetLocation()
 			cmds = append(cmds, cmd)
 
+		case key.Matches(msg, m.keys.Category):
+			m.handleCategoryDialog()
+
+		case key.Matches(msg, m.keys.Tags):
+			m.handleTagDialog()
+
+		case key.Matches(msg, m.keys.Rename):
+			m.handleRenameTorrent()
+
+		case key.Matches(msg, m.keys.Limits):
+			m.handleLimitsDialog()
+
+		case key.Matches(msg, m.keys.ShareLimits):
+			m.handleShareLimitsDialog()
+
+		case key.Matches(msg, m.keys.Trackers):
+			m.handleReplaceTrackers()
+
+		case key.Matches(msg, m.keys.AddPeers):
+			m.handleAddPeers()
+
+		case key.Matches(msg, m.keys.Preferences):
+			cmds = append(cmds, m.handleOpenPreferences())
+
+		case key.Matches(msg, m.keys.RSS):
+			cmds = append(cmds, m.handleOpenRSS())
+
+		case key.Matches(msg, m.keys.Search):
+			cmds = append(cmds, m.handleOpenSearch())
+
+		case key.Matches(msg, m.keys.Log):
+			cmds = append(cmds, m.handleOpenLog())
+
+		case key.Matches(msg, m.keys.AltSpeed):
+			cmd = m.handleToggleAltSpeed()
+			cmds = append(cmds, cmd)
+
+		// Selection - only meaningful in the torrent list
+		case key.Matches(msg, m.keys.Mark):
+			if m.viewMode == ViewModeMain {
+				m.torrentList.ToggleMark()
+			}
+
+		case key.Matches(msg, m.keys.VisualSelect):
+			if m.viewMode == ViewModeMain {
+				m.torrentList.ToggleVisual()
+			}
+
+		case key.Matches(msg, m.keys.SelectAll):
+			if m.viewMode == ViewModeMain {
+				m.torrentList.MarkAll()
+			}
+
+		case key.Matches(msg, m.keys.InvertSelection):
+			if m.viewMode == ViewModeMain {
+				m.torrentList.InvertMarks()
+			}
+
 		// Handle global filter keys BEFORE passing to components (to avoid conflicts)
 		case msg.String() == "s": // State filter
 			if m.viewMode == ViewModeMain && !m.filterPanel.IsInInteractiveMode() {
@@ -947,11 +1514,50 @@ func (m *MainView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.PasteMsg:
-		if m.showAddDialog && m.addDialog.mode == ModeURL {
+		if m.viewMode == ViewModePreferences {
+			if m.preferences != nil && m.preferences.editing {
+				m.preferences.input = appendPrintable(m.preferences.input, msg.Content)
+			}
+		} else if m.viewMode == ViewModeRSS {
+			if m.rss != nil && m.rss.editor != nil && m.rss.editor.editing {
+				m.rss.editor.input = appendPrintable(m.rss.editor.input, msg.Content)
+			} else if m.rss != nil && m.rss.prompt != rssPromptNone {
+				m.rss.input = appendPrintable(m.rss.input, strings.TrimSpace(msg.Content))
+			}
+		} else if m.viewMode == ViewModeSearch {
+			if m.search != nil && m.search.editingQuery {
+				m.search.query = appendPrintable(m.search.query, strings.Join(strings.Fields(msg.Content), " "))
+			}
+		} else if m.viewMode == ViewModeLog {
+			if m.serverLog != nil && m.serverLog.editingQuery {
+				m.serverLog.query = appendPrintable(m.serverLog.query, strings.Join(strings.Fields(msg.Content), " "))
+				m.serverLog.filtersChanged()
+			}
+		} else if m.showAddDialog && m.addDialog.options != nil {
+			m.addDialog.options.appendInput(strings.TrimSpace(msg.Content))
+		} else if m.showAddDialog && m.addDialog.mode == ModeURL {
 			m.addDialog.urlInput.url = appendPrintable(m.addDialog.urlInput.url, msg.Content)
 		} else if m.showLocationDialog && m.locationDialog.mode == LocationModeText {
 			m.locationDialog.pathInput.path = appendPrintable(m.locationDialog.pathInput.path, msg.Content)
 			m.locationDialog.pathInput.cursor = len(m.locationDialog.pathInput.path)
+		} else if m.showCategoryDialog && m.categoryDialog.mode == CategoryModeEdit {
+			m.categoryDialog.pasteText(msg.Content)
+		} else if m.showTagDialog && m.tagDialog.mode == TagModeCreate {
+			m.tagDialog.newTagInput = appendPrintable(m.tagDialog.newTagInput, msg.Content)
+		} else if m.showRenameDialog {
+			m.renameDialog.input = appendPrintable(m.renameDialog.input, msg.Content)
+		} else if m.showLimitsDialog {
+			input := m.limitsDialog.focusedLimitInput()
+			*input = appendPrintable(*input, msg.Content)
+		} else if m.showShareLimitsDialog {
+			m.shareLimitsDialog.appendInput(msg.Content)
+		} else if m.showTrackerDialog {
+			// Pasted tracker lists are one URL per line
+			input := m.trackerDialog.focusedTrackerInput()
+			*input = appendPrintable(*input, strings.Join(strings.Fields(msg.Content), " "))
+		} else if m.showPeerDialog {
+			// Pasted peer lists are often one address per line
+			m.peerDialog.input = appendPrintable(m.peerDialog.input, strings.Join(strings.Fields(msg.Content), " "))
 		} else if m.filterPanel.IsInInputMode() {
 			m.filterPanel, cmd = m.filterPanel.Update(msg)
 			cmds = append(cmds, cmd)
@@ -969,6 +1575,14 @@ func (m *MainView) View() tea.View {
 		content = "Loading..."
 	} else if m.viewMode == ViewModeDetails {
 		content = m.renderDetailsView()
+	} else if m.viewMode == ViewModePreferences {
+		content = m.renderPreferencesView()
+	} else if m.viewMode == ViewModeRSS {
+		content = m.renderRSSView()
+	} else if m.viewMode == ViewModeSearch {
+		content = m.renderSearchView()
+	} else if m.viewMode == ViewModeLog {
+		content = m.renderLogView()
 	} else {
 		content = m.renderMainView()
 	}
@@ -1003,6 +1617,12 @@ func (m *MainView) renderMainView() string {
 	// Create the layout
 	var sections []string
 
+	// Connection banner while the server is unreachable, so stale data is obvious
+	if banner := m.connection.renderBanner(m.lastRefreshTime, m.width); banner != "" {
+		sections = append(sections, banner)
+		torrentListHeight--
+	}
+
 	// Stats panel at the top
 	statsView := m.renderStatsPanel(m.width, statsHeight)
 	sections = append(sections, statsView)
@@ -1021,6 +1641,8 @@ func (m *MainView) renderMainView() string {
 		statusView = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.lastError))
 	} else if m.lastSuccess != "" {
 		statusView = styles.SuccessStyle.Render(fmt.Sprintf("✓ %s", m.lastSuccess))
+	} else if m.torrentList.HasMarks() {
+		statusView = m.renderSelectionStatus() + "  " + m.help.View(m.keys)
 	} else {
 		statusView = m.help.View(m.keys)
 	}
@@ -1028,24 +1650,47 @@ func (m *MainView) renderMainView() string {
 
 	mainContent := lipgloss.JoinVertical(lipgloss.Left, sections...)
 
-	// Overlay dialogs if active (add torrent has priority)
-	if m.showAddDialog {
-		dialog := m.renderAddDialog()
-		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog)
+	// Overlay dialogs if active
+	if dialog, ok := m.renderActiveDialog(); ok {
+		return dialog
 	}
 
-	if m.showLocationDialog {
-		dialog := m.renderLocationDialog()
-		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog)
-	}
+	return mainContent
+}
 
-	if m.showDeleteDialog {
-		dialog := m.renderDeleteDialog()
-		// Simple overlay - place dialog in center
-		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog)
+// renderActiveDialog renders the open dialog, if any, centered on screen.
+// Dialogs are checked in the same priority order as their key handling.
+func (m *MainView) renderActiveDialog() (string, bool) {
+	var dialog string
+	switch {
+	case m.showAddDialog:
+		dialog = m.renderAddDialog()
+	case m.showLocationDialog:
+		dialog = m.renderLocationDialog()
+	case m.showCategoryDialog:
+		dialog = m.renderCategoryDialog()
+	case m.showTagDialog:
+		dialog = m.renderTagDialog()
+	case m.showRenameDialog:
+		dialog = m.renderRenameDialog()
+	case m.showLimitsDialog:
+		dialog = m.renderLimitsDialog()
+	case m.showShareLimitsDialog:
+		dialog = m.renderShareLimitsDialog()
+	case m.showTrackerDialog:
+		dialog = m.renderTrackerDialog()
+	case m.showRemoveTrackerDialog:
+		dialog = m.renderRemoveTrackerDialog()
+	case m.showPeerDialog:
+		dialog = m.renderPeerDialog()
+	case m.showBanPeerDialog:
+		dialog = m.renderBanPeerDialog()
+	case m.showDeleteDialog:
+		dialog = m.renderDeleteDialog()
+	default:
+		return "", false
 	}
-
-	return mainContent
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog), true
 }
 
 // renderStatsPanel renders the stats panel
@@ -1103,95 +1748,226 @@ func (m *MainView) applyFilter() {
 	m.torrentList.SetTorrents(m.torrents)
 }
 
-// handlePauseTorrent pauses the currently selected torrent
+// handlePauseTorrent pauses the marked torrents, or the selected one if none are marked
 func (m *MainView) handlePauseTorrent() tea.Cmd {
-	selectedHash := m.getSelectedTorrentHash()
-	if selectedHash == "" {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
 		// Return an error message to help debug
 		return func() tea.Msg {
 			return errorMsg(fmt.Errorf("no torrent selected"))
 		}
 	}
 
-	// Find the selected torrent to get its name for the success message
-	var torrentName string
-	for _, torrent := range m.torrents {
-		if torrent.Hash == selectedHash {
-			torrentName = torrent.Name
-			break
+	// Resolve the display name now so the success message doesn't depend on later state
+	target := m.describeTorrents(hashes)
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		err := m.apiClient.PauseTorrents(ctx, hashes)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to pause %s: %w", pluralTorrents(len(hashes)), err))
+		}
+		// Return success message
+		return successMsg(fmt.Sprintf("paused: %s", target))
+	}
+}
+
+// handleResumeTorrent resumes the marked torrents, or the selected one if none are marked
+func (m *MainView) handleResumeTorrent() tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("no torrent selected"))
 		}
 	}
 
+	target := m.describeTorrents(hashes)
+
 	return func() tea.Msg {
 		ctx := context.Background()
-		err := m.apiClient.PauseTorrents(ctx, []string{selectedHash})
+		err := m.apiClient.ResumeTorrents(ctx, hashes)
 		if err != nil {
-			return errorMsg(fmt.Errorf("failed to pause torrent: %w", err))
+			return errorMsg(fmt.Errorf("failed to resume %s: %w", pluralTorrents(len(hashes)), err))
 		}
 		// Return success message
-		return successMsg(fmt.Sprintf("paused: %s", styles.TruncateString(torrentName, 40)))
+		return successMsg(fmt.Sprintf("resumed: %s", target))
 	}
 }
 
-// handleResumeTorrent resumes the currently selected torrent
-func (m *MainView) handleResumeTorrent() tea.Cmd {
-	selectedHash := m.getSelectedTorrentHash()
-	if selectedHash == "" {
+// handleRecheckTorrent rechecks the marked torrents, or the selected one if none are marked
+func (m *MainView) handleRecheckTorrent() tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
 		return func() tea.Msg {
 			return errorMsg(fmt.Errorf("no torrent selected"))
 		}
 	}
 
-	// Find the selected torrent to get its name for the success message
-	var torrentName string
-	for _, torrent := range m.torrents {
-		if torrent.Hash == selectedHash {
-			torrentName = torrent.Name
-			break
+	target := m.describeTorrents(hashes)
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		err := m.apiClient.RecheckTorrents(ctx, hashes)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to recheck %s: %w", pluralTorrents(len(hashes)), err))
+		}
+		return successMsg(fmt.Sprintf("rechecking: %s", target))
+	}
+}
+
+// handleReannounceTorrent reannounces the marked torrents, or the selected one if none are marked
+func (m *MainView) handleReannounceTorrent() tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("no torrent selected"))
 		}
 	}
 
+	target := m.describeTorrents(hashes)
+
 	return func() tea.Msg {
 		ctx := context.Background()
-		err := m.apiClient.ResumeTorrents(ctx, []string{selectedHash})
+		err := m.apiClient.ReannounceTorrents(ctx, hashes)
 		if err != nil {
-			return errorMsg(fmt.Errorf("failed to resume torrent: %w", err))
+			return errorMsg(fmt.Errorf("failed to reannounce %s: %w", pluralTorrents(len(hashes)), err))
 		}
-		// Return success message
-		return successMsg(fmt.Sprintf("resumed: %s", styles.TruncateString(torrentName, 40)))
+		return successMsg(fmt.Sprintf("reannounced: %s", target))
 	}
 }
 
-// handleDeleteTorrent shows confirmation dialog for deleting the currently selected torrent
-func (m *MainView) handleDeleteTorrent() tea.Cmd {
-	selectedHash := m.getSelectedTorrentHash()
-	if selectedHash == "" {
+// handleToggleSetting flips an on/off setting on the marked torrents, or the
+// selected one if none are marked. The API toggles each torrent separately, so
+// only torrents that differ from the target state are sent: mixed selections
+// all end up enabled.
+func (m *MainView) handleToggleSetting(name string, enabled func(api.Torrent) bool, toggle func(ctx context.Context, hashes []string) error) tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
 		return func() tea.Msg {
 			return errorMsg(fmt.Errorf("no torrent selected"))
 		}
 	}
 
-	// Find the selected torrent to read its hash and name
-	var torrentName string
-	found := false
-	for _, torrent := range m.torrents {
-		if torrent.Hash == selectedHash {
-			torrentName = torrent.Name
-			found = true
+	enable := false
+	for _, hash := range hashes {
+		if !enabled(m.torrentMap[hash]) {
+			enable = true
 			break
 		}
 	}
+	var changed []string
+	for _, hash := range hashes {
+		if enabled(m.torrentMap[hash]) != enable {
+			changed = append(changed, hash)
+		}
+	}
+	target := m.describeTorrents(hashes)
 
-	if !found {
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := toggle(ctx, changed); err != nil {
+			return errorMsg(fmt.Errorf("failed to toggle %s on %s: %w", name, pluralTorrents(len(changed)), err))
+		}
+		if enable {
+			return successMsg(fmt.Sprintf("%s enabled: %s", name, target))
+		}
+		return successMsg(fmt.Sprintf("%s disabled: %s", name, target))
+	}
+}
+
+// handleQueueMove moves the marked torrents, or the selected one if none are
+// marked, within the download queue using one of the priority API calls
+func (m *MainView) handleQueueMove(move func(ctx context.Context, hashes []string) error, done string) tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
 		return func() tea.Msg {
-			return errorMsg(fmt.Errorf("selected torrent not found"))
+			return errorMsg(fmt.Errorf("no torrent selected"))
+		}
+	}
+
+	target := m.describeTorrents(hashes)
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := move(ctx, hashes); err != nil {
+			return errorMsg(fmt.Errorf("failed to reorder queue (is queueing enabled?): %w", err))
+		}
+		return successMsg(fmt.Sprintf("%s: %s", done, target))
+	}
+}
+
+// handleForceStartTorrent toggles force start on the marked torrents, or the
+// selected one if none are marked. Mixed selections are all force started.
+func (m *MainView) handleForceStartTorrent() tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("no torrent selected"))
+		}
+	}
+
+	enable := false
+	for _, hash := range hashes {
+		if !m.torrentMap[hash].ForceStart {
+			enable = true
+			break
+		}
+	}
+	target := m.describeTorrents(hashes)
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		err := m.apiClient.SetForceStart(ctx, hashes, enable)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to set force start on %s: %w", pluralTorrents(len(hashes)), err))
+		}
+		if enable {
+			return successMsg(fmt.Sprintf("force started: %s", target))
+		}
+		return successMsg(fmt.Sprintf("force start disabled: %s", target))
+	}
+}
+
+// handleToggleAltSpeed switches alternative speed limits on or off
+func (m *MainView) handleToggleAltSpeed() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := m.apiClient.ToggleAltSpeedLimits(ctx); err != nil {
+			return errorMsg(fmt.Errorf("failed to toggle alternative speed limits: %w", err))
+		}
+		enabled, err := m.apiClient.GetAltSpeedLimitsMode(ctx)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to get alternative speed limits mode: %w", err))
+		}
+		if enabled {
+			return successMsg("alternative speed limits enabled")
+		}
+		return successMsg("alternative speed limits disabled")
+	}
+}
+
+// handleDeleteTorrent shows confirmation dialog for deleting the marked or selected torrents
+func (m *MainView) handleDeleteTorrent() tea.Cmd {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("no torrent selected"))
+		}
+	}
+
+	// Make sure a single target still exists before asking for confirmation
+	if len(hashes) == 1 {
+		if _, found := m.torrentMap[hashes[0]]; !found {
+			return func() tea.Msg {
+				return errorMsg(fmt.Errorf("selected torrent not found"))
+			}
 		}
 	}
 
 	// Show confirmation dialog instead of immediate deletion
 	m.showDeleteDialog = true
-	m.deleteTargetHash = selectedHash
-	m.deleteTargetName = torrentName
+	m.deleteTargetHashes = hashes
+	m.deleteTargetName = m.describeTorrents(hashes)
 	m.deleteWithFiles = false // Default to not deleting files
 
 	return nil // No command needed, just update UI state
@@ -1199,27 +1975,27 @@ func (m *MainView) handleDeleteTorrent() tea.Cmd {
 
 // confirmDeleteTorrent performs the actual deletion after user confirmation
 func (m *MainView) confirmDeleteTorrent() tea.Cmd {
-	if m.deleteTargetHash == "" {
+	if len(m.deleteTargetHashes) == 0 {
 		return nil
 	}
 
-	hash := m.deleteTargetHash
-	torrentName := m.deleteTargetName
+	hashes := m.deleteTargetHashes
+	target := m.deleteTargetName
 	deleteFiles := m.deleteWithFiles
 
 	// Close dialog and clear state
 	m.showDeleteDialog = false
-	m.deleteTargetHash = ""
+	m.deleteTargetHashes = nil
 	m.deleteTargetName = ""
 
 	return func() tea.Msg {
 		ctx := context.Background()
-		err := m.apiClient.DeleteTorrents(ctx, []string{hash}, deleteFiles)
+		err := m.apiClient.DeleteTorrents(ctx, hashes, deleteFiles)
 		if err != nil {
-			return errorMsg(fmt.Errorf("failed to delete torrent: %w", err))
+			return errorMsg(fmt.Errorf("failed to delete %s: %w", pluralTorrents(len(hashes)), err))
 		}
 		// Return success message
-		successText := fmt.Sprintf("deleted: %s", styles.TruncateString(torrentName, 40))
+		successText := fmt.Sprintf("deleted: %s", target)
 		if deleteFiles {
 			successText += " (with files)"
 		}
@@ -1230,7 +2006,7 @@ func (m *MainView) confirmDeleteTorrent() tea.Cmd {
 // cancelDeleteTorrent cancels the delete operation
 func (m *MainView) cancelDeleteTorrent() {
 	m.showDeleteDialog = false
-	m.deleteTargetHash = ""
+	m.deleteTargetHashes = nil
 	m.deleteTargetName = ""
 	m.deleteWithFiles = false
 }
@@ -1247,6 +2023,47 @@ func (m *MainView) getSelectedTorrentHash() string {
 	return ""
 }
 
+// getActionTorrentHashes returns the hashes a torrent action should apply to.
+// In the main view this is the marked set (falling back to the cursor row);
+// the details view always acts on the single torrent being viewed.
+func (m *MainView) getActionTorrentHashes() []string {
+	if m.viewMode == ViewModeMain {
+		return m.torrentList.GetActionHashes()
+	}
+	if hash := m.getSelectedTorrentHash(); hash != "" {
+		return []string{hash}
+	}
+	return nil
+}
+
+// describeTorrents returns a short label for a set of torrents: the torrent
+// name for a single hash, otherwise a count
+func (m *MainView) describeTorrents(hashes []string) string {
+	if len(hashes) == 1 {
+		if torrent, found := m.torrentMap[hashes[0]]; found {
+			return styles.TruncateString(torrent.Name, 40)
+		}
+	}
+	return pluralTorrents(len(hashes))
+}
+
+// pluralTorrents formats a torrent count, e.g. "1 torrent" or "12 torrents"
+func pluralTorrents(n int) string {
+	if n == 1 {
+		return "1 torrent"
+	}
+	return fmt.Sprintf("%d torrents", n)
+}
+
+// renderSelectionStatus renders the marked count / visual mode indicator
+func (m *MainView) renderSelectionStatus() string {
+	count := len(m.torrentList.GetMarkedHashes())
+	if m.torrentList.IsInVisualMode() {
+		return styles.AccentStyle.Render(fmt.Sprintf("-- VISUAL -- %d marked", count))
+	}
+	return styles.AccentStyle.Render(fmt.Sprintf("%d marked", count))
+}
+
 // extractCategoryNames extracts category names from the categories map
 func (m *MainView) extractCategoryNames() []string {
 	var names []string
@@ -1343,20 +2160,9 @@ func (m *MainView) renderDetailsView() string {
 
 	mainContent := lipgloss.JoinVertical(lipgloss.Left, detailsPanel, statusView)
 
-	// Overlay dialogs if active (add torrent has priority)
-	if m.showAddDialog {
-		dialog := m.renderAddDialog()
-		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog)
-	}
-
-	if m.showLocationDialog {
-		dialog := m.renderLocationDialog()
-		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog)
-	}
-
-	if m.showDeleteDialog {
-		dialog := m.renderDeleteDialog()
-		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, dialog)
+	// Overlay dialogs if active
+	if dialog, ok := m.renderActiveDialog(); ok {
+		return dialog
 	}
 
 	return mainContent
@@ -1364,7 +2170,7 @@ func (m *MainView) renderDetailsView() string {
 
 // renderDeleteDialog renders the delete confirmation dialog
 func (m *MainView) renderDeleteDialog() string {
-	if m.deleteTargetHash == "" {
+	if len(m.deleteTargetHashes) == 0 {
 		return ""
 	}
 
@@ -1378,6 +2184,9 @@ func (m *MainView) renderDeleteDialog() string {
 
 	// Title
 	title := styles.AccentStyle.Render("Delete Torrent")
+	if len(m.deleteTargetHashes) > 1 {
+		title = styles.AccentStyle.Render(fmt.Sprintf("Delete %d Torrents", len(m.deleteTargetHashes)))
+	}
 
 	// Torrent name (truncated if too long)
 	torrentName := m.deleteTargetName
@@ -1385,6 +2194,9 @@ func (m *MainView) renderDeleteDialog() string {
 		torrentName = torrentName[:47] + "..."
 	}
 	nameText := fmt.Sprintf("Torrent: %s", styles.TextStyle.Render(torrentName))
+	if len(m.deleteTargetHashes) > 1 {
+		nameText = fmt.Sprintf("Torrents: %s", styles.TextStyle.Render(torrentName))
+	}
 
 	// File deletion option
 	var fileText string
@@ -1422,6 +2234,10 @@ func (m *MainView) renderAddDialog() string {
 		Height(25).
 		Align(lipgloss.Center)
 
+	if m.addDialog.options != nil {
+		return dialogStyle.Render(m.renderAddOptions())
+	}
+
 	// Title with mode tabs
 	title := styles.AccentStyle.Render("Add Torrent")
 
@@ -1632,7 +2448,7 @@ func (m *MainView) renderLocationDialog() string {
 	if m.locationDialog.mode == LocationModeText {
 		content = m.renderPathInput()
 	} else {
-		content = m.renderLocationBrowser()
+		content = renderRemoteBrowser(m.locationDialog.remoteNav, "Enter: Set as location")
 	}
 
 	// Instructions
@@ -1690,10 +2506,9 @@ func (m *MainView) renderPathInput() string {
 	)
 }
 
-// renderLocationBrowser renders the directory browser component
-func (m *MainView) renderLocationBrowser() string {
-	nav := m.locationDialog.remoteNav
-
+// renderRemoteBrowser renders a server directory browser; chooseHint
+// describes what Enter does with the highlighted directory
+func renderRemoteBrowser(nav *RemoteFileNavigator, chooseHint string) string {
 	// Current path display
 	pathDisplay := fmt.Sprintf("📁 %s", styles.DimStyle.Render(nav.currentPath))
 
@@ -1753,7 +2568,7 @@ func (m *MainView) renderLocationBrowser() string {
 	}
 
 	// Instructions
-	navInstructions := styles.DimStyle.Render("↑↓: Navigate  Enter: Set as location  h: Up  l: Browse into")
+	navInstructions := styles.DimStyle.Render("↑↓: Navigate  " + chooseHint + "  h: Up  l: Browse into")
 
 	// Combine all parts
 	content := []string{pathDisplay}
@@ -1889,8 +2704,8 @@ func (m *MainView) handleFileNavigatorKeys(key string) tea.Cmd {
 				nav.currentPath = selected.fullPath
 				nav.readDirectory()
 			} else {
-				// Select file for upload
-				return m.addTorrentFile(selected.fullPath)
+				// Pick the file, then choose the options
+				m.openAddOptions(selected.fullPath, true)
 			}
 		}
 	case "h", "backspace":
@@ -1913,7 +2728,7 @@ func (m *MainView) handleFileNavigatorKeys(key string) tea.Cmd {
 		if nav.searchMode {
 			if key == "backspace" {
 				if len(nav.searchPattern) > 0 {
-					nav.searchPattern = nav.searchPattern[:len(nav.searchPattern)-1]
+					nav.searchPattern = deleteLastRune(nav.searchPattern)
 					nav.applyFilter()
 				}
 			} else if len(key) == 1 {
@@ -1939,6 +2754,35 @@ func appendPrintable(dst, s string) string {
 	return b.String()
 }
 
+// deleteLastRune removes the last character of s, keeping multi-byte
+// characters intact
+func deleteLastRune(s string) string {
+	_, size := utf8.DecodeLastRuneInString(s)
+	return s[:len(s)-size]
+}
+
+// listRow renders a list row, highlighted under the cursor; the highlight
+// is dimmed while another pane has focus
+func listRow(line string, selected, focused bool) string {
+	switch {
+	case selected && focused:
+		return styles.SelectedRowStyle.Render(line)
+	case selected:
+		return styles.AccentStyle.Render(line)
+	}
+	return styles.TextStyle.Render(line)
+}
+
+// scrollWindow returns the lines that fit in height, scrolled to keep the
+// cursor line in view
+func scrollWindow[T any](lines []T, cursor, height int) []T {
+	if height <= 0 || len(lines) <= height {
+		return lines
+	}
+	start := min(max(cursor-height/2, 0), len(lines)-height)
+	return lines[start : start+height]
+}
+
 // handleURLInputKeys handles keyboard input for URL input
 func (m *MainView) handleURLInputKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
 	urlInput := m.addDialog.urlInput
@@ -1946,12 +2790,10 @@ func (m *MainView) handleURLInputKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
 	switch keyMsg.String() {
 	case "enter":
 		if len(urlInput.url) > 0 {
-			return m.addTorrentURL(urlInput.url)
+			m.openAddOptions(urlInput.url, false)
 		}
 	case "backspace":
-		if len(urlInput.url) > 0 {
-			urlInput.url = urlInput.url[:len(urlInput.url)-1]
-		}
+		urlInput.url = deleteLastRune(urlInput.url)
 	case "ctrl+a":
 		urlInput.url = ""
 	default:
@@ -1974,7 +2816,7 @@ func (m *MainView) handlePathInputKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
 		}
 	case "backspace":
 		if len(pathInput.path) > 0 {
-			pathInput.path = pathInput.path[:len(pathInput.path)-1]
+			pathInput.path = deleteLastRune(pathInput.path)
 			pathInput.cursor = len(pathInput.path)
 		}
 	case "ctrl+a":
@@ -1993,10 +2835,9 @@ func (m *MainView) handlePathInputKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
-// handleLocationBrowserKeys handles keyboard input for directory browser
-func (m *MainView) handleLocationBrowserKeys(key string) tea.Cmd {
-	nav := m.locationDialog.remoteNav
-
+// handleRemoteBrowserKeys handles keyboard input for a server directory
+// browser; choose is called with the directory picked with Enter
+func (m *MainView) handleRemoteBrowserKeys(nav *RemoteFileNavigator, key string, choose func(string) tea.Cmd) tea.Cmd {
 	switch key {
 	case "up", "k":
 		if nav.selectedIdx > 0 {
@@ -2012,15 +2853,15 @@ func (m *MainView) handleLocationBrowserKeys(key string) tea.Cmd {
 			selectedPath := nav.directories[nav.selectedIdx]
 			// Special handling for parent directory
 			if selectedPath == ".." {
-				// Set location to parent directory
+				// Choose the parent directory
 				parentPath := path.Dir(nav.currentPath)
-				return m.confirmSetLocation(parentPath)
+				return choose(parentPath)
 			}
 			// API returns full paths, use directly
-			return m.confirmSetLocation(selectedPath)
+			return choose(selectedPath)
 		}
 		// If no directory selected, use current directory
-		return m.confirmSetLocation(nav.currentPath)
+		return choose(nav.currentPath)
 	case "h", "backspace":
 		// Go up one directory
 		if nav.currentPath != "/" && nav.currentPath != "" {
@@ -2050,12 +2891,13 @@ func (m *MainView) handleLocationBrowserKeys(key string) tea.Cmd {
 }
 
 // addTorrentFile adds a torrent from a local file
-func (m *MainView) addTorrentFile(filePath string) tea.Cmd {
+func (m *MainView) addTorrentFile(filePath string, opts api.AddTorrentOptions) tea.Cmd {
 	m.showAddDialog = false
+	m.addDialog.options = nil
 
 	return func() tea.Msg {
 		ctx := context.Background()
-		err := m.apiClient.AddTorrentFile(ctx, filePath)
+		err := m.apiClient.AddTorrentFile(ctx, filePath, opts)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to add torrent file: %w", err))
 		}
@@ -2065,12 +2907,13 @@ func (m *MainView) addTorrentFile(filePath string) tea.Cmd {
 }
 
 // addTorrentURL adds a torrent from a URL
-func (m *MainView) addTorrentURL(url string) tea.Cmd {
+func (m *MainView) addTorrentURL(url string, opts api.AddTorrentOptions) tea.Cmd {
 	m.showAddDialog = false
+	m.addDialog.options = nil
 
 	return func() tea.Msg {
 		ctx := context.Background()
-		err := m.apiClient.AddTorrentURL(ctx, url)
+		err := m.apiClient.AddTorrentURL(ctx, url, opts)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to add torrent from URL: %w", err))
 		}
@@ -2078,37 +2921,27 @@ func (m *MainView) addTorrentURL(url string) tea.Cmd {
 	}
 }
 
-// handleSetLocation shows the set location dialog for the selected torrent
+// handleSetLocation shows the set location dialog for the marked or selected torrents
 func (m *MainView) handleSetLocation() tea.Cmd {
-	selectedHash := m.getSelectedTorrentHash()
-	if selectedHash == "" {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
 		return func() tea.Msg {
 			return errorMsg(fmt.Errorf("no torrent selected"))
 		}
 	}
 
-	// Find the selected torrent to read its current save path and name
-	var savePath, torrentName string
-	found := false
-	for _, torrent := range m.torrents {
-		if torrent.Hash == selectedHash {
-			savePath = torrent.SavePath
-			torrentName = torrent.Name
-			found = true
-			break
-		}
-	}
-
+	// Start from the first target's save path; for bulk moves it is only a hint
+	torrent, found := m.torrentMap[hashes[0]]
 	if !found {
 		return func() tea.Msg {
 			return errorMsg(fmt.Errorf("selected torrent not found"))
 		}
 	}
 
-	// Store hash and name for later use (avoiding stale pointer issues)
-	m.locationTargetHash = selectedHash
-	m.locationTargetName = torrentName
-	m.locationDialog = NewLocationDialog(m.apiClient, savePath, torrentName)
+	// Store hashes and name for later use (avoiding stale pointer issues)
+	m.locationTargetHashes = hashes
+	m.locationTargetName = m.describeTorrents(hashes)
+	m.locationDialog = NewLocationDialog(m.apiClient, torrent.SavePath, m.locationTargetName)
 	m.showLocationDialog = true
 
 	// Trigger initial directory load if in browser mode
@@ -2117,27 +2950,27 @@ func (m *MainView) handleSetLocation() tea.Cmd {
 
 // confirmSetLocation performs the location change
 func (m *MainView) confirmSetLocation(newLocation string) tea.Cmd {
-	if m.locationTargetHash == "" {
+	if len(m.locationTargetHashes) == 0 {
 		return nil
 	}
 
-	hash := m.locationTargetHash
-	torrentName := m.locationTargetName
+	hashes := m.locationTargetHashes
+	target := m.locationTargetName
 
 	// Close dialog and clear state
 	m.showLocationDialog = false
-	m.locationTargetHash = ""
+	m.locationTargetHashes = nil
 	m.locationTargetName = ""
 	m.locationDialog = nil
 
 	return func() tea.Msg {
 		ctx := context.Background()
-		err := m.apiClient.SetTorrentLocation(ctx, []string{hash}, newLocation)
+		err := m.apiClient.SetTorrentLocation(ctx, hashes, newLocation)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to set location: %w", err))
 		}
 		// Return success message with name and path
-		successText := fmt.Sprintf("%s -> %s", styles.TruncateString(torrentName, 30), newLocation)
+		successText := fmt.Sprintf("%s -> %s", styles.TruncateString(target, 30), newLocation)
 		return successMsg(successText)
 	}
 }
@@ -2145,20 +2978,34 @@ func (m *MainView) confirmSetLocation(newLocation string) tea.Cmd {
 // cancelSetLocation cancels the set location operation
 func (m *MainView) cancelSetLocation() {
 	m.showLocationDialog = false
-	m.locationTargetHash = ""
+	m.locationTargetHashes = nil
 	m.locationTargetName = ""
 	m.locationDialog = nil
 }
 
+// activeRemoteNav returns the server directory browser of the open dialog,
+// or nil when none is open
+func (m *MainView) activeRemoteNav() *RemoteFileNavigator {
+	if m.locationDialog != nil {
+		return m.locationDialog.remoteNav
+	}
+	if m.showAddDialog && m.addDialog.options != nil && m.addDialog.options.browsing {
+		return m.addDialog.options.remoteNav
+	}
+	return nil
+}
+
 // loadDirectoryContent loads directory contents from the qBittorrent server
+// into the open directory browser
 func (m *MainView) loadDirectoryContent(path string) tea.Cmd {
-	if m.locationDialog == nil || m.locationDialog.remoteNav == nil {
+	nav := m.activeRemoteNav()
+	if nav == nil {
 		return nil
 	}
 
 	// Mark as loading
-	m.locationDialog.remoteNav.loading = true
-	m.locationDialog.remoteNav.loadError = nil
+	nav.loading = true
+	nav.loadError = nil
 
 	return func() tea.Msg {
 		ctx := context.Background()
@@ -2224,6 +3071,7 @@ func (m *MainView) updateTerminalTitle() {
 		titleData.UpSpeed = m.stats.UpInfoSpeed
 		titleData.SessionDownloaded = m.stats.DlInfoData
 		titleData.SessionUploaded = m.stats.UpInfoData
+		titleData.AltSpeedEnabled = m.stats.UseAltSpeedLimits
 	}
 
 	// Render title template
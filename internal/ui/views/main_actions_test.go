@@ -0,0 +1,81 @@
+package views
+
+import (
+	"testing"
+
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestForceStartToggle(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{
+		{Hash: "hash1", Name: "one", ForceStart: true},
+		{Hash: "hash2", Name: "two"},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	for _, torrent := range mock.Torrents {
+		m.torrentMap[torrent.Hash] = torrent
+	}
+	m.torrentList.SetTorrents(mock.Torrents)
+	m.torrentList.MarkAll()
+
+	// A mixed selection is force started as a whole
+	if msg, ok := m.handleForceStartTorrent()().(successMsg); !ok {
+		t.Fatalf("expected success, got %#v", msg)
+	}
+	for _, torrent := range mock.Torrents {
+		if !torrent.ForceStart {
+			t.Errorf("%s should be force started", torrent.Hash)
+		}
+		m.torrentMap[torrent.Hash] = torrent
+	}
+
+	// Once all are force started the toggle disables it
+	m.handleForceStartTorrent()()
+	for _, torrent := range mock.Torrents {
+		if torrent.ForceStart {
+			t.Errorf("%s should no longer be force started", torrent.Hash)
+		}
+	}
+}
+
+func TestRecheckWithoutSelection(t *testing.T) {
+	m := newTestMainView()
+	m.apiClient = api.NewMockClient()
+
+	if _, ok := m.handleRecheckTorrent()().(errorMsg); !ok {
+		t.Error("recheck without a selected torrent should produce an error")
+	}
+}
+
+func TestToggleSettingAlignsMixedSelection(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{
+		{Hash: "hash1", Name: "one", SeqDownload: true},
+		{Hash: "hash2", Name: "two"},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	for _, torrent := range mock.Torrents {
+		m.torrentMap[torrent.Hash] = torrent
+	}
+	m.torrentList.SetTorrents(mock.Torrents)
+	m.torrentList.MarkAll()
+
+	// Only the disabled torrent is toggled, so both end up enabled
+	cmd := m.handleToggleSetting("sequential download",
+		func(t api.Torrent) bool { return t.SeqDownload }, mock.ToggleSequentialDownload)
+	if msg, ok := cmd().(successMsg); !ok || msg != "sequential download enabled: 2 torrents" {
+		t.Fatalf("unexpected result %#v", msg)
+	}
+	for _, torrent := range mock.Torrents {
+		if !torrent.SeqDownload {
+			t.Errorf("%s should have sequential download enabled", torrent.Hash)
+		}
+	}
+}
@@ -33,6 +33,27 @@ func TestAppendPrintable(t *testing.T) {
 	}
 }
 
+func TestDeleteLastRune(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty", "", ""},
+		{"ascii", "abc", "ab"},
+		{"accented", "/données/séries/é", "/données/séries/"},
+		{"cjk", "動画", "動"},
+		{"emoji", "tag🎬", "tag"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deleteLastRune(tt.s); got != tt.want {
+				t.Errorf("deleteLastRune(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
 func newTestMainView() *MainView {
 	cfg := &config.Config{}
 	return &MainView{
@@ -166,3 +187,22 @@ func TestPasteFilterSearchIgnoredWhenDialogOpen(t *testing.T) {
 		t.Error("filter search should not receive paste when add dialog is open")
 	}
 }
+
+func TestPasteIntoCategoryForm(t *testing.T) {
+	m := newTestMainView()
+	m.showCategoryDialog = true
+	m.categoryDialog = NewCategoryDialog(nil, "")
+	m.categoryDialog.mode = CategoryModeEdit
+	m.categoryDialog.isNew = true
+
+	m.Update(tea.PasteMsg{Content: "tv/shows"})
+	m.categoryDialog.focusedField = CategoryFieldSavePath
+	m.Update(tea.PasteMsg{Content: "/mnt/tv"})
+
+	if m.categoryDialog.nameInput != "tv/shows" {
+		t.Errorf("paste into category name: got %q, want %q", m.categoryDialog.nameInput, "tv/shows")
+	}
+	if m.categoryDialog.savePathInput != "/mnt/tv" {
+		t.Errorf("paste into category save path: got %q, want %q", m.categoryDialog.savePathInput, "/mnt/tv")
+	}
+}
@@ -0,0 +1,59 @@
+package views
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestSyncMaintainsCategoriesAndTags(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{{Hash: "hash1", Name: "alpha", Category: "movies", Tags: "hd"}}
+	mock.Categories = map[string]api.Category{
+		"movies": {Name: "movies", SavePath: "/downloads/movies"},
+		"empty":  {Name: "empty"},
+	}
+	mock.Tags = []string{"hd", "unused"}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	sync := func() {
+		data, err := mock.SyncMainData(context.Background(), m.currentRID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.Update(syncDataMsg(data))
+	}
+
+	// Categories and tags without torrents are listed too
+	sync()
+	if got := m.extractCategoryNames(); !slices.Equal(got, []string{"empty", "movies"}) {
+		t.Errorf("categories: got %v", got)
+	}
+	if !slices.Equal(m.tags, []string{"hd", "unused"}) {
+		t.Errorf("tags: got %v", m.tags)
+	}
+
+	// Incremental updates add and remove entries
+	delete(mock.Categories, "empty")
+	mock.Categories["tv"] = api.Category{Name: "tv"}
+	mock.Tags = []string{"4k", "hd"}
+	sync()
+	if got := m.extractCategoryNames(); !slices.Equal(got, []string{"movies", "tv"}) {
+		t.Errorf("categories after update: got %v", got)
+	}
+	if !slices.Equal(m.tags, []string{"4k", "hd"}) {
+		t.Errorf("tags after update: got %v", m.tags)
+	}
+
+	// The filter panel offers the server's list
+	m.filterPanel.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if view := m.filterPanel.View(); !strings.Contains(view, "tv") {
+		t.Errorf("category filter should list tv, got:\n%s", view)
+	}
+}
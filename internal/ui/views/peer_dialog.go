@@ -0,0 +1,213 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/components"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// PeerDialog represents the add peers dialog state
+type PeerDialog struct {
+	hashes      []string
+	targetName  string
+	input       string // Peer addresses, separated by spaces or commas
+	fromDetails bool   // Opened from the Peers tab, which reloads afterwards
+}
+
+// handleAddPeersRequest opens the add peers dialog from the Peers tab
+func (m *MainView) handleAddPeersRequest(msg components.AddPeersRequestMsg) {
+	m.peerDialog = &PeerDialog{
+		hashes:      []string{msg.Hash},
+		targetName:  m.describeTorrents([]string{msg.Hash}),
+		fromDetails: true,
+	}
+	m.showPeerDialog = true
+}
+
+// handleAddPeers opens the add peers dialog for the marked or selected torrents
+func (m *MainView) handleAddPeers() {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return
+	}
+	m.peerDialog = &PeerDialog{
+		hashes:     hashes,
+		targetName: m.describeTorrents(hashes),
+	}
+	m.showPeerDialog = true
+}
+
+// closePeerDialog closes the add peers dialog and clears its state
+func (m *MainView) closePeerDialog() {
+	m.showPeerDialog = false
+	m.peerDialog = nil
+}
+
+// validatePeerAddress checks that a peer is an "ip:port" address, with IPv6
+// addresses in brackets
+func validatePeerAddress(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || net.ParseIP(host) == nil {
+		return fmt.Errorf("invalid peer %q (expected ip:port, e.g. 10.0.0.5:6881 or [2001:db8::1]:6881)", addr)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("invalid port in peer %q", addr)
+	}
+	return nil
+}
+
+// peerAddresses splits the dialog input into peer addresses; several can be
+// separated by spaces, commas or newlines
+func peerAddresses(input string) []string {
+	return strings.FieldsFunc(input, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\n' || r == '\t'
+	})
+}
+
+// handlePeerDialogKeys handles keyboard input for the add peers dialog
+func (m *MainView) handlePeerDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.peerDialog
+
+	switch keyMsg.String() {
+	case "esc":
+		m.closePeerDialog()
+	case "enter":
+		return m.applyPeerDialog()
+	case "backspace":
+		d.input = deleteLastRune(d.input)
+	case "ctrl+u":
+		d.input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			d.input = appendPrintable(d.input, keyMsg.Text)
+		}
+	}
+
+	return nil
+}
+
+// applyPeerDialog validates the addresses and asks the torrents to connect
+func (m *MainView) applyPeerDialog() tea.Cmd {
+	d := m.peerDialog
+	fail := func(err error) tea.Cmd {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+
+	peers := peerAddresses(d.input)
+	if len(peers) == 0 {
+		return fail(fmt.Errorf("enter at least one peer address"))
+	}
+	for _, peer := range peers {
+		if err := validatePeerAddress(peer); err != nil {
+			return fail(err)
+		}
+	}
+
+	hashes, target, fromDetails := d.hashes, d.targetName, d.fromDetails
+	m.closePeerDialog()
+
+	return func() tea.Msg {
+		err := m.apiClient.AddPeers(context.Background(), hashes, peers)
+		if err != nil {
+			err = fmt.Errorf("failed to add peers: %w", err)
+		}
+		success := fmt.Sprintf("added %d peer(s) to %s", len(peers), target)
+		if fromDetails {
+			return components.DetailsActionMsg{Success: success, Err: err}
+		}
+		if err != nil {
+			return errorMsg(err)
+		}
+		return successMsg(success)
+	}
+}
+
+// handleBanPeerRequest asks for confirmation before banning a peer
+func (m *MainView) handleBanPeerRequest(msg components.PeerBanRequestMsg) {
+	m.banPeerTarget = msg
+	m.showBanPeerDialog = true
+}
+
+// confirmBanPeer bans the peer after user confirmation
+func (m *MainView) confirmBanPeer() tea.Cmd {
+	address := m.banPeerTarget.Address
+	m.cancelBanPeer()
+
+	return func() tea.Msg {
+		if err := m.apiClient.BanPeers(context.Background(), []string{address}); err != nil {
+			return components.DetailsActionMsg{Err: fmt.Errorf("failed to ban peer: %w", err)}
+		}
+		return components.DetailsActionMsg{Success: fmt.Sprintf("banned peer: %s", address)}
+	}
+}
+
+// cancelBanPeer closes the ban confirmation dialog
+func (m *MainView) cancelBanPeer() {
+	m.showBanPeerDialog = false
+	m.banPeerTarget = components.PeerBanRequestMsg{}
+}
+
+// renderPeerDialog renders the add peers dialog
+func (m *MainView) renderPeerDialog() string {
+	d := m.peerDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	input := styles.FocusedInputStyle.Width(60).Render(styles.TextStyle.Render(d.input + "▊"))
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		styles.AccentStyle.Render("Add Peers"),
+		styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(d.targetName, 50))),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, "Peers (ip:port):", input),
+		styles.DimStyle.Render("Separate several peers with spaces, e.g. 10.0.0.5:6881 [2001:db8::1]:6881"),
+		"",
+		styles.DimStyle.Render("Enter: Add  Ctrl+U: Clear  Esc: Cancel"),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
+
+// renderBanPeerDialog renders the ban peer confirmation dialog
+func (m *MainView) renderBanPeerDialog() string {
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(60).
+		Align(lipgloss.Center)
+
+	peer := m.banPeerTarget.Address
+	if m.banPeerTarget.Client != "" {
+		peer += " (" + styles.TruncateString(m.banPeerTarget.Client, 25) + ")"
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		styles.AccentStyle.Render("Ban Peer"),
+		"",
+		fmt.Sprintf("Peer: %s", styles.TextStyle.Render(peer)),
+		"",
+		styles.DimStyle.Render("The IP is banned for all torrents until removed"),
+		styles.DimStyle.Render("from the IP filter in qBittorrent's preferences"),
+		"",
+		styles.DimStyle.Render("Y/Enter: Ban  N/Esc: Cancel"),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
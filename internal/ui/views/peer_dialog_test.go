@@ -0,0 +1,89 @@
+package views
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/components"
+)
+
+func TestValidatePeerAddress(t *testing.T) {
+	tests := []struct {
+		addr  string
+		valid bool
+	}{
+		{"10.0.0.5:6881", true},
+		{"[2001:db8::1]:51413", true},
+		{"10.0.0.5", false},
+		{"2001:db8::1:6881", false},
+		{"tracker.example:6881", false},
+		{"10.0.0.5:0", false},
+		{"10.0.0.5:70000", false},
+	}
+
+	for _, tt := range tests {
+		if err := validatePeerAddress(tt.addr); (err == nil) != tt.valid {
+			t.Errorf("validatePeerAddress(%q): got err %v, want valid=%v", tt.addr, err, tt.valid)
+		}
+	}
+}
+
+func TestPeerDialogAddPastedList(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.handleAddPeersRequest(components.AddPeersRequestMsg{Hash: "hash1"})
+	m.Update(tea.PasteMsg{Content: "10.0.0.5:6881\n[2001:db8::1]:51413\n"})
+
+	msg, ok := m.applyPeerDialog()().(components.DetailsActionMsg)
+	if !ok || msg.Err != nil {
+		t.Fatalf("expected success reported to the Peers tab, got %#v", msg)
+	}
+	if len(mock.Peers["hash1"]) != 2 {
+		t.Errorf("both pasted peers should be added, got %v", mock.Peers["hash1"])
+	}
+	if m.showPeerDialog {
+		t.Error("dialog should close after adding")
+	}
+
+	// Invalid input keeps the dialog open
+	m.handleAddPeersRequest(components.AddPeersRequestMsg{Hash: "hash1"})
+	m.peerDialog.input = "10.0.0.5:6881 10.0.0.6"
+	if _, ok := m.applyPeerDialog()().(errorMsg); !ok || !m.showPeerDialog {
+		t.Error("a peer without a port should be rejected")
+	}
+}
+
+func TestBanPeerConfirmation(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Peers["hash1"] = map[string]api.Peer{"10.0.0.5:6881": {IP: "10.0.0.5", Port: 6881}}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.torrentDetails = components.NewTorrentDetails(mock)
+
+	m.Update(components.PeerBanRequestMsg{Address: "10.0.0.5:6881", Client: "Transmission 4.0"})
+	if !m.showBanPeerDialog {
+		t.Fatal("ban request should ask for confirmation")
+	}
+	m.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if m.showBanPeerDialog || len(mock.BannedPeers) != 0 {
+		t.Fatal("n should cancel without banning")
+	}
+
+	m.Update(components.PeerBanRequestMsg{Address: "10.0.0.5:6881"})
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("expected a command to ban the peer")
+	}
+	if msg, ok := cmd().(components.DetailsActionMsg); !ok || msg.Err != nil {
+		t.Fatalf("unexpected result %#v", msg)
+	}
+	if len(mock.BannedPeers) != 1 || len(mock.Peers["hash1"]) != 0 {
+		t.Errorf("peer should be banned, got banned %v peers %v", mock.BannedPeers, mock.Peers["hash1"])
+	}
+}
@@ -0,0 +1,580 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// Message types for the preferences view
+type (
+	preferencesMsg      *api.Preferences
+	preferencesSavedMsg int // Number of preferences saved
+)
+
+// preferenceField is one editable setting in the preferences view. Switches
+// and choices set toggle; everything else is edited as text and set parse.
+type preferenceField struct {
+	section string
+	label   string
+	hint    string // Accepted input, shown while editing
+
+	format  func(p *api.Preferences) string
+	parse   func(p *api.Preferences, input string) error
+	toggle  func(p *api.Preferences, step int)
+	changed func(a, b *api.Preferences) bool
+	reset   func(dst, src *api.Preferences)
+}
+
+// newPreferenceField sets up the comparison and reset of a field's value
+func newPreferenceField[T comparable](section, label string, value func(*api.Preferences) *T) preferenceField {
+	return preferenceField{
+		section: section,
+		label:   label,
+		changed: func(a, b *api.Preferences) bool { return *value(a) != *value(b) },
+		reset:   func(dst, src *api.Preferences) { *value(dst) = *value(src) },
+	}
+}
+
+// boolPreference is an on/off switch
+func boolPreference(section, label string, value func(*api.Preferences) *bool) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.format = func(p *api.Preferences) string {
+		if *value(p) {
+			return "✓ on"
+		}
+		return "✗ off"
+	}
+	f.toggle = func(p *api.Preferences, _ int) { *value(p) = !*value(p) }
+	return f
+}
+
+// choicePreference cycles through named options stored as their index
+func choicePreference(section, label string, options []string, value func(*api.Preferences) *int) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.format = func(p *api.Preferences) string {
+		if i := *value(p); i >= 0 && i < len(options) {
+			return options[i]
+		}
+		return strconv.Itoa(*value(p))
+	}
+	f.toggle = func(p *api.Preferences, step int) {
+		*value(p) = ((*value(p)+step)%len(options) + len(options)) % len(options)
+	}
+	return f
+}
+
+// pathPreference is a directory on the server
+func pathPreference(section, label string, value func(*api.Preferences) *string) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.hint = "Path on the qBittorrent server"
+	f.format = func(p *api.Preferences) string { return *value(p) }
+	f.parse = func(p *api.Preferences, input string) error {
+		*value(p) = strings.TrimSpace(input)
+		return nil
+	}
+	return f
+}
+
+// numberPreference is a whole number within [min, max]; with unlimited set,
+// -1 means no limit and is shown as ∞
+func numberPreference(section, label, unit string, min, max int, unlimited bool, value func(*api.Preferences) *int) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.hint = fmt.Sprintf("%d-%d", min, max)
+	if unit != "" {
+		f.hint += " " + unit
+	}
+	if unlimited {
+		f.hint += ", or ∞ for unlimited"
+	}
+	f.format = func(p *api.Preferences) string {
+		if unlimited && *value(p) < 0 {
+			return "∞"
+		}
+		if unit != "" {
+			return fmt.Sprintf("%d %s", *value(p), unit)
+		}
+		return strconv.Itoa(*value(p))
+	}
+	f.parse = func(p *api.Preferences, input string) error {
+		input = strings.ToLower(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(input), unit)))
+		if unlimited {
+			switch input {
+			case "∞", "-1", "unlimited", "none":
+				*value(p) = -1
+				return nil
+			}
+		}
+		n, err := strconv.Atoi(input)
+		if err != nil || n < min || n > max {
+			return fmt.Errorf("%s must be %s", strings.ToLower(label), f.hint)
+		}
+		*value(p) = n
+		return nil
+	}
+	return f
+}
+
+// speedPreference is a speed limit in bytes/s where 0 means unlimited
+func speedPreference(section, label string, value func(*api.Preferences) *int64) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.hint = "e.g. 500K, 2.5M, or 0 for unlimited"
+	f.format = func(p *api.Preferences) string { return styles.FormatSpeedLimit(*value(p)) }
+	f.parse = func(p *api.Preferences, input string) error {
+		limit, err := styles.ParseSpeedLimit(input)
+		if err != nil {
+			return err
+		}
+		*value(p) = limit
+		return nil
+	}
+	return f
+}
+
+// ratioPreference is a share ratio
+func ratioPreference(section, label string, value func(*api.Preferences) *float64) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.hint = "e.g. 1, 2.5"
+	f.format = func(p *api.Preferences) string {
+		if *value(p) < 0 {
+			return "∞"
+		}
+		return strconv.FormatFloat(*value(p), 'f', -1, 64)
+	}
+	f.parse = func(p *api.Preferences, input string) error {
+		ratio, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
+		if err != nil || ratio < 0 {
+			return fmt.Errorf("invalid ratio %q (examples: 1, 2.5)", input)
+		}
+		*value(p) = ratio
+		return nil
+	}
+	return f
+}
+
+// seedingTimePreference is a duration in minutes
+func seedingTimePreference(section, label string, value func(*api.Preferences) *int64) preferenceField {
+	f := newPreferenceField(section, label, value)
+	f.hint = "e.g. 90 (minutes), 12h, 7d"
+	f.format = func(p *api.Preferences) string { return styles.FormatSeedingTime(*value(p)) }
+	f.parse = func(p *api.Preferences, input string) error {
+		minutes, err := styles.ParseSeedingTime(input)
+		if err != nil {
+			return err
+		}
+		*value(p) = minutes
+		return nil
+	}
+	return f
+}
+
+// preferenceFields lists the editable preferences in display order
+var preferenceFields = []preferenceField{
+	pathPreference("Downloads", "Default save path", func(p *api.Preferences) *string { return &p.SavePath }),
+	boolPreference("Downloads", "Keep incomplete torrents in temp path", func(p *api.Preferences) *bool { return &p.TempPathEnabled }),
+	pathPreference("Downloads", "Temp path", func(p *api.Preferences) *string { return &p.TempPath }),
+	boolPreference("Downloads", "Automatic torrent management", func(p *api.Preferences) *bool { return &p.AutoTMMEnabled }),
+	boolPreference("Downloads", "Pre-allocate disk space", func(p *api.Preferences) *bool { return &p.PreallocateAll }),
+	boolPreference("Downloads", "Append .!qB to incomplete files", func(p *api.Preferences) *bool { return &p.IncompleteFilesExt }),
+
+	numberPreference("Connection", "Listening port", "", 1, 65535, false, func(p *api.Preferences) *int { return &p.ListenPort }),
+	boolPreference("Connection", "UPnP / NAT-PMP port forwarding", func(p *api.Preferences) *bool { return &p.UPnP }),
+	numberPreference("Connection", "Max connections", "", 1, 65535, true, func(p *api.Preferences) *int { return &p.MaxConnec }),
+	numberPreference("Connection", "Max connections per torrent", "", 1, 65535, true, func(p *api.Preferences) *int { return &p.MaxConnecPerTorrent }),
+	numberPreference("Connection", "Max upload slots", "", 1, 65535, true, func(p *api.Preferences) *int { return &p.MaxUploads }),
+	numberPreference("Connection", "Max upload slots per torrent", "", 1, 65535, true, func(p *api.Preferences) *int { return &p.MaxUploadsPerTorrent }),
+
+	speedPreference("Speed", "Download limit", func(p *api.Preferences) *int64 { return &p.DlLimit }),
+	speedPreference("Speed", "Upload limit", func(p *api.Preferences) *int64 { return &p.UpLimit }),
+	speedPreference("Speed", "Alternative download limit", func(p *api.Preferences) *int64 { return &p.AltDlLimit }),
+	speedPreference("Speed", "Alternative upload limit", func(p *api.Preferences) *int64 { return &p.AltUpLimit }),
+	boolPreference("Speed", "Apply limits to µTP", func(p *api.Preferences) *bool { return &p.LimitUTPRate }),
+	boolPreference("Speed", "Apply limits to transport overhead", func(p *api.Preferences) *bool { return &p.LimitTCPOverhead }),
+	boolPreference("Speed", "Apply limits to LAN peers", func(p *api.Preferences) *bool { return &p.LimitLANPeers }),
+	boolPreference("Speed", "Schedule alternative limits", func(p *api.Preferences) *bool { return &p.SchedulerEnabled }),
+
+	boolPreference("BitTorrent", "DHT", func(p *api.Preferences) *bool { return &p.DHT }),
+	boolPreference("BitTorrent", "Peer exchange (PeX)", func(p *api.Preferences) *bool { return &p.PeX }),
+	boolPreference("BitTorrent", "Local peer discovery", func(p *api.Preferences) *bool { return &p.LSD }),
+	choicePreference("BitTorrent", "Encryption", []string{"Allow", "Require", "Disable"}, func(p *api.Preferences) *int { return &p.Encryption }),
+	boolPreference("BitTorrent", "Anonymous mode", func(p *api.Preferences) *bool { return &p.AnonymousMode }),
+	boolPreference("BitTorrent", "Limit share ratio", func(p *api.Preferences) *bool { return &p.MaxRatioEnabled }),
+	ratioPreference("BitTorrent", "Share ratio limit", func(p *api.Preferences) *float64 { return &p.MaxRatio }),
+	boolPreference("BitTorrent", "Limit seeding time", func(p *api.Preferences) *bool { return &p.MaxSeedingTimeEnabled }),
+	seedingTimePreference("BitTorrent", "Seeding time limit", func(p *api.Preferences) *int64 { return &p.MaxSeedingTime }),
+
+	boolPreference("Queueing", "Torrent queueing", func(p *api.Preferences) *bool { return &p.QueueingEnabled }),
+	numberPreference("Queueing", "Max active downloads", "", 0, 65535, true, func(p *api.Preferences) *int { return &p.MaxActiveDownloads }),
+	numberPreference("Queueing", "Max active uploads", "", 0, 65535, true, func(p *api.Preferences) *int { return &p.MaxActiveUploads }),
+	numberPreference("Queueing", "Max active torrents", "", 0, 65535, true, func(p *api.Preferences) *int { return &p.MaxActiveTorrents }),
+	boolPreference("Queueing", "Don't count slow torrents", func(p *api.Preferences) *bool { return &p.DontCountSlowTorrents }),
+	numberPreference("Queueing", "Slow download rate threshold", "KiB/s", 0, 1<<20, false, func(p *api.Preferences) *int { return &p.SlowTorrentDlRateThreshold }),
+	numberPreference("Queueing", "Slow upload rate threshold", "KiB/s", 0, 1<<20, false, func(p *api.Preferences) *int { return &p.SlowTorrentUlRateThreshold }),
+	numberPreference("Queueing", "Slow torrent inactivity timer", "s", 0, 1<<20, false, func(p *api.Preferences) *int { return &p.SlowTorrentInactiveTimer }),
+}
+
+// PreferencesEditor holds the state of the preferences view
+type PreferencesEditor struct {
+	original *api.Preferences
+	edited   api.Preferences
+	cursor   int
+
+	editing bool // Typing a new value for the field under the cursor
+	input   string
+	err     error // Why the typed value was rejected
+
+	preview        bool // Reviewing the changes before saving
+	confirmDiscard bool // Esc pressed once with unsaved changes
+	scroll         int
+}
+
+// newPreferencesEditor starts editing a copy of the server's preferences
+func newPreferencesEditor(prefs *api.Preferences) *PreferencesEditor {
+	return &PreferencesEditor{
+		original: prefs,
+		edited:   *prefs,
+	}
+}
+
+// changedFields returns the fields whose value differs from the server's
+func (e *PreferencesEditor) changedFields() []preferenceField {
+	var changed []preferenceField
+	for _, field := range preferenceFields {
+		if field.changed(&e.edited, e.original) {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+// validatePreferences checks rules that span several fields
+func validatePreferences(p *api.Preferences) error {
+	if p.SavePath == "" {
+		return fmt.Errorf("default save path can't be empty")
+	}
+	if p.TempPathEnabled && p.TempPath == "" {
+		return fmt.Errorf("set a temp path or turn off keeping incomplete torrents there")
+	}
+	if p.MaxRatioEnabled && p.MaxRatio < 0 {
+		return fmt.Errorf("set a share ratio limit or turn off limiting the ratio")
+	}
+	if p.MaxSeedingTimeEnabled && p.MaxSeedingTime < 0 {
+		return fmt.Errorf("set a seeding time limit or turn off limiting seeding time")
+	}
+	return nil
+}
+
+// handleOpenPreferences switches to the preferences view and loads them
+func (m *MainView) handleOpenPreferences() tea.Cmd {
+	m.viewMode = ViewModePreferences
+	m.preferences = nil
+	return m.fetchPreferences()
+}
+
+// fetchPreferences loads the application preferences from the server
+func (m *MainView) fetchPreferences() tea.Cmd {
+	return func() tea.Msg {
+		prefs, err := m.apiClient.GetPreferences(context.Background())
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load preferences: %w", err))
+		}
+		return preferencesMsg(prefs)
+	}
+}
+
+// handlePreferencesLoaded starts editing freshly loaded preferences, keeping
+// the cursor when they were reloaded after a save
+func (m *MainView) handlePreferencesLoaded(prefs *api.Preferences) {
+	if m.viewMode != ViewModePreferences {
+		return
+	}
+	editor := newPreferencesEditor(prefs)
+	if m.preferences != nil {
+		editor.cursor = m.preferences.cursor
+		editor.scroll = m.preferences.scroll
+	}
+	m.preferences = editor
+}
+
+// closePreferences returns to the torrent list, discarding unsaved changes
+func (m *MainView) closePreferences() {
+	m.viewMode = ViewModeMain
+	m.preferences = nil
+}
+
+// handlePreferencesKeys handles keyboard input in the preferences view
+func (m *MainView) handlePreferencesKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	e := m.preferences
+	if e == nil {
+		// Still loading
+		if keyMsg.String() == "esc" {
+			m.closePreferences()
+		}
+		return nil
+	}
+
+	switch {
+	case e.editing:
+		e.handleEditKeys(keyMsg)
+		return nil
+	case e.preview:
+		switch keyMsg.String() {
+		case "y", "Y", "enter":
+			return m.savePreferences()
+		case "n", "N", "esc":
+			e.preview = false
+		}
+		return nil
+	}
+
+	field := preferenceFields[e.cursor]
+	if keyMsg.String() != "esc" {
+		e.confirmDiscard = false
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		if len(e.changedFields()) > 0 && !e.confirmDiscard {
+			e.confirmDiscard = true
+			return nil
+		}
+		m.closePreferences()
+	case "up", "k":
+		if e.cursor > 0 {
+			e.cursor--
+		}
+	case "down", "j":
+		if e.cursor < len(preferenceFields)-1 {
+			e.cursor++
+		}
+	case "g":
+		e.cursor = 0
+	case "G":
+		e.cursor = len(preferenceFields) - 1
+	case "tab":
+		e.cursor = nextPreferenceSection(e.cursor, 1)
+	case "shift+tab":
+		e.cursor = nextPreferenceSection(e.cursor, -1)
+	case "enter", "space", " ":
+		if field.toggle != nil {
+			field.toggle(&e.edited, 1)
+		} else {
+			e.editing = true
+			e.input = field.format(&e.edited)
+			e.err = nil
+		}
+	case "right", "l":
+		if field.toggle != nil {
+			field.toggle(&e.edited, 1)
+		}
+	case "left", "h":
+		if field.toggle != nil {
+			field.toggle(&e.edited, -1)
+		}
+	case "u":
+		field.reset(&e.edited, e.original)
+	case "s":
+		if len(e.changedFields()) == 0 {
+			return func() tea.Msg {
+				return errorMsg(fmt.Errorf("no preferences changed"))
+			}
+		}
+		if err := validatePreferences(&e.edited); err != nil {
+			return func() tea.Msg {
+				return errorMsg(err)
+			}
+		}
+		e.preview = true
+	}
+	return nil
+}
+
+// handleEditKeys handles typing a new value for the field under the cursor
+func (e *PreferencesEditor) handleEditKeys(keyMsg tea.KeyPressMsg) {
+	switch keyMsg.String() {
+	case "esc":
+		e.editing = false
+		e.err = nil
+	case "enter":
+		if err := preferenceFields[e.cursor].parse(&e.edited, e.input); err != nil {
+			e.err = err
+			return
+		}
+		e.editing = false
+		e.err = nil
+	case "backspace":
+		e.input = deleteLastRune(e.input)
+	case "ctrl+u":
+		e.input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			e.input = appendPrintable(e.input, keyMsg.Text)
+		}
+	}
+}
+
+// nextPreferenceSection returns the first field of the next (dir 1) or
+// previous (dir -1) section, wrapping around
+func nextPreferenceSection(cursor, dir int) int {
+	n := len(preferenceFields)
+	section := preferenceFields[cursor].section
+	if dir < 0 {
+		// Move to the start of the current section first
+		for cursor > 0 && preferenceFields[cursor-1].section == section {
+			cursor--
+		}
+		cursor = (cursor - 1 + n) % n
+		section = preferenceFields[cursor].section
+		for cursor > 0 && preferenceFields[cursor-1].section == section {
+			cursor--
+		}
+		return cursor
+	}
+	for i := 1; i < n; i++ {
+		next := (cursor + i) % n
+		if preferenceFields[next].section != section {
+			return next
+		}
+	}
+	return cursor
+}
+
+// savePreferences sends only the changed preferences to the server
+func (m *MainView) savePreferences() tea.Cmd {
+	e := m.preferences
+	changes := e.edited.Changes(e.original)
+	e.preview = false
+
+	return func() tea.Msg {
+		if err := m.apiClient.SetPreferences(context.Background(), changes); err != nil {
+			return errorMsg(fmt.Errorf("failed to save preferences: %w", err))
+		}
+		return preferencesSavedMsg(len(changes))
+	}
+}
+
+// renderPreferencesView renders the preferences editor in place of the
+// torrent list
+func (m *MainView) renderPreferencesView() string {
+	helpHeight := strings.Count(m.help.View(m.keys), "\n") + 1
+	contentHeight := m.height - helpHeight - 1
+	visibleLines := max(contentHeight-2, 1) // Panel borders
+
+	var content string
+	switch e := m.preferences; {
+	case e == nil:
+		content = styles.DimStyle.Render("Loading preferences...")
+	case e.preview:
+		content = e.renderDiff()
+	default:
+		content = e.renderFields(visibleLines)
+	}
+	panel := styles.FocusedPanelStyle.Width(m.width).Height(contentHeight).Render(content)
+
+	// Status line at the bottom - priority: error (red) > success (green) > help
+	var statusView string
+	if m.lastError != nil {
+		statusView = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.lastError))
+	} else if m.lastSuccess != "" {
+		statusView = styles.SuccessStyle.Render(fmt.Sprintf("✓ %s", m.lastSuccess))
+	} else {
+		statusView = m.renderPreferencesHelp()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, panel, statusView)
+}
+
+// renderPreferencesHelp renders the key hints for the current editor state
+func (m *MainView) renderPreferencesHelp() string {
+	e := m.preferences
+	switch {
+	case e == nil:
+		return styles.DimStyle.Render("Esc back")
+	case e.editing:
+		return styles.DimStyle.Render("Enter apply • Ctrl+U clear • Esc cancel")
+	case e.preview:
+		return styles.DimStyle.Render("Y/Enter save • N/Esc keep editing")
+	case e.confirmDiscard:
+		return styles.WarningStyle.Render("Unsaved changes - press Esc again to discard them, or s to review and save")
+	}
+	return styles.DimStyle.Render("↑↓ select • Tab next section • Enter edit/toggle • ←→ cycle • u revert • s review & save • Esc back")
+}
+
+// renderFields renders the preference list, scrolled to keep the cursor visible
+func (e *PreferencesEditor) renderFields(visibleLines int) string {
+	var lines []string
+	cursorLine := 0
+	section := ""
+	for i, field := range preferenceFields {
+		if field.section != section {
+			if section != "" {
+				lines = append(lines, "")
+			}
+			section = field.section
+			lines = append(lines, styles.SubtitleStyle.Render(section))
+		}
+
+		value := field.format(&e.edited)
+		if i == e.cursor && e.editing {
+			value = e.input + "▊"
+		}
+		marker := "  "
+		if field.changed(&e.edited, e.original) {
+			marker = "* "
+		}
+		line := fmt.Sprintf("%s%-40s %s", marker, field.label, value)
+
+		switch {
+		case i == e.cursor:
+			cursorLine = len(lines)
+			lines = append(lines, styles.SelectedRowStyle.Render(line))
+			if e.editing {
+				hint := styles.DimStyle.Render("    " + field.hint)
+				if e.err != nil {
+					hint = styles.ErrorStyle.Render("    " + e.err.Error())
+				}
+				lines = append(lines, hint)
+			}
+		case marker != "  ":
+			lines = append(lines, styles.AccentStyle.Render(line))
+		default:
+			lines = append(lines, styles.TextStyle.Render(line))
+		}
+	}
+
+	// Keep the cursor (and its hint line) on screen
+	if cursorLine < e.scroll {
+		e.scroll = cursorLine
+	} else if cursorLine+1 >= e.scroll+visibleLines {
+		e.scroll = cursorLine + 2 - visibleLines
+	}
+	e.scroll = max(min(e.scroll, len(lines)-visibleLines), 0)
+	end := min(e.scroll+visibleLines, len(lines))
+
+	return strings.Join(lines[e.scroll:end], "\n")
+}
+
+// renderDiff renders the pending changes for review before saving
+func (e *PreferencesEditor) renderDiff() string {
+	changed := e.changedFields()
+	lines := []string{
+		styles.TitleStyle.Render(fmt.Sprintf("Save %d changed preference(s)?", len(changed))),
+		"",
+	}
+	for _, field := range changed {
+		lines = append(lines, fmt.Sprintf("%s › %s: %s → %s",
+			styles.DimStyle.Render(field.section),
+			field.label,
+			styles.ErrorStyle.Render(field.format(e.original)),
+			styles.SuccessStyle.Render(field.format(&e.edited)),
+		))
+	}
+	lines = append(lines, "", styles.DimStyle.Render("Only these settings are sent; everything else stays as it is on the server."))
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,155 @@
+package views
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+// preferencesClient records the preferences sent to the server
+type preferencesClient struct {
+	*api.MockClient
+	sent map[string]any
+}
+
+func (c *preferencesClient) SetPreferences(ctx context.Context, changes map[string]any) error {
+	c.sent = changes
+	return c.MockClient.SetPreferences(ctx, changes)
+}
+
+func pressKeys(m *MainView, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		switch k {
+		case "enter":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		case "esc":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+		case "down":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+		case "tab":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+		case "backspace":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+		case "right":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+		case "space":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+		case "ctrl+u":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: 'u', Mod: tea.ModCtrl})
+		case "ctrl+b":
+			_, cmd = m.Update(tea.KeyPressMsg{Code: 'b', Mod: tea.ModCtrl})
+		default:
+			_, cmd = m.Update(tea.KeyPressMsg{Code: []rune(k)[0], Text: k})
+		}
+	}
+	return cmd
+}
+
+func fieldIndex(t *testing.T, label string) int {
+	t.Helper()
+	for i, field := range preferenceFields {
+		if field.label == label {
+			return i
+		}
+	}
+	t.Fatalf("no preference field %q", label)
+	return -1
+}
+
+func TestPreferencesEditorSavesOnlyChanges(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	client := &preferencesClient{MockClient: mock}
+
+	m := newTestMainView()
+	m.apiClient = client
+	m.Update(pressKeys(m, ",")())
+	if m.viewMode != ViewModePreferences || m.preferences == nil {
+		t.Fatal("preferences should load into their own view")
+	}
+
+	// Toggle DHT
+	m.preferences.cursor = fieldIndex(t, "DHT")
+	pressKeys(m, "enter")
+
+	// An invalid port keeps the editor open with an error
+	m.preferences.cursor = fieldIndex(t, "Listening port")
+	pressKeys(m, "enter", "ctrl+u", "9", "9", "9", "9", "9", "9", "enter")
+	if !m.preferences.editing || m.preferences.err == nil {
+		t.Fatal("port 999999 should be rejected")
+	}
+	pressKeys(m, "ctrl+u", "5", "1", "4", "1", "3", "enter")
+	if m.preferences.editing || m.preferences.edited.ListenPort != 51413 {
+		t.Fatalf("port should be set, got %d", m.preferences.edited.ListenPort)
+	}
+
+	pressKeys(m, "s")
+	if !m.preferences.preview || len(m.preferences.changedFields()) != 2 {
+		t.Fatal("s should show the two pending changes")
+	}
+	m.width, m.height = 100, 30
+	if view := m.renderPreferencesView(); !strings.Contains(view, "Save 2 changed preference(s)?") {
+		t.Errorf("preview should replace the field list, got:\n%s", view)
+	}
+	if diff := m.preferences.renderDiff(); !strings.Contains(diff, "Listening port") || !strings.Contains(diff, "51413") {
+		t.Errorf("diff should list the port change, got:\n%s", diff)
+	}
+
+	msg := pressKeys(m, "y")()
+	if saved, ok := msg.(preferencesSavedMsg); !ok || saved != 2 {
+		t.Fatalf("unexpected save result %#v", msg)
+	}
+	if len(client.sent) != 2 || client.sent["dht"] != false {
+		t.Errorf("only the changed keys should be sent, got %v", client.sent)
+	}
+	if mock.Preferences.ListenPort != 51413 || mock.Preferences.DHT {
+		t.Errorf("server preferences not updated: %+v", mock.Preferences)
+	}
+}
+
+func TestPreferencesEditorValidationAndDiscard(t *testing.T) {
+	m := newTestMainView()
+	m.viewMode = ViewModePreferences
+	m.handlePreferencesLoaded(&api.Preferences{SavePath: "/data"})
+
+	// Enabling the temp path without one fails validation on save
+	m.preferences.cursor = fieldIndex(t, "Keep incomplete torrents in temp path")
+	pressKeys(m, "space")
+	if _, ok := pressKeys(m, "s")().(errorMsg); !ok || m.preferences.preview {
+		t.Error("save should be refused without a temp path")
+	}
+
+	// u reverts the field under the cursor
+	pressKeys(m, "u")
+	if m.preferences.edited.TempPathEnabled {
+		t.Error("u should revert the field")
+	}
+
+	// Leaving with unsaved changes asks first
+	pressKeys(m, "space", "esc")
+	if m.viewMode != ViewModePreferences || !m.preferences.confirmDiscard {
+		t.Fatal("first esc should warn about unsaved changes")
+	}
+	pressKeys(m, "esc")
+	if m.viewMode != ViewModeMain {
+		t.Error("second esc should discard and leave")
+	}
+}
+
+func TestNextPreferenceSection(t *testing.T) {
+	connection := fieldIndex(t, "Listening port")
+	speed := fieldIndex(t, "Download limit")
+	if got := nextPreferenceSection(0, 1); got != connection {
+		t.Errorf("tab from Downloads: got %d, want %d", got, connection)
+	}
+	if got := nextPreferenceSection(speed+2, -1); got != connection {
+		t.Errorf("shift+tab from inside Speed: got %d, want %d", got, connection)
+	}
+	if got := nextPreferenceSection(0, -1); preferenceFields[got].section != "Queueing" {
+		t.Errorf("shift+tab from the top should wrap to Queueing, got %s", preferenceFields[got].section)
+	}
+}
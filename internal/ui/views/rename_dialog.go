@@ -0,0 +1,199 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/components"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// RenameTarget identifies what the rename dialog renames
+type RenameTarget int
+
+const (
+	RenameTorrent RenameTarget = iota
+	RenameFile
+	RenameFolder
+)
+
+// RenameDialog represents the rename editor state. For files and folders
+// only the last path component is edited; the parent directory is kept.
+type RenameDialog struct {
+	target  RenameTarget
+	hash    string
+	oldPath string // Torrent name, or file/folder path within the torrent
+	input   string
+}
+
+// NewRenameDialog creates a rename dialog prefilled with the current name
+func NewRenameDialog(target RenameTarget, hash, oldPath string) *RenameDialog {
+	input := oldPath
+	if target != RenameTorrent {
+		input = path.Base(oldPath)
+	}
+	return &RenameDialog{
+		target:  target,
+		hash:    hash,
+		oldPath: oldPath,
+		input:   input,
+	}
+}
+
+// newPath returns the full new name or path for the current input
+func (d *RenameDialog) newPath() string {
+	name := strings.TrimSpace(d.input)
+	if d.target == RenameTorrent {
+		return name
+	}
+	if dir := path.Dir(d.oldPath); dir != "." {
+		return dir + "/" + name
+	}
+	return name
+}
+
+// validateRename checks a new torrent, file or folder name
+func validateRename(target RenameTarget, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if target != RenameTorrent {
+		if strings.ContainsAny(name, `/\`) {
+			return fmt.Errorf("name cannot contain path separators: %s", name)
+		}
+		if name == "." || name == ".." {
+			return fmt.Errorf("invalid name: %s", name)
+		}
+	}
+	return nil
+}
+
+// handleRenameTorrent opens the rename dialog for the torrent under the cursor
+func (m *MainView) handleRenameTorrent() {
+	hash := m.getSelectedTorrentHash()
+	torrent, found := m.torrentMap[hash]
+	if !found {
+		return
+	}
+	m.renameDialog = NewRenameDialog(RenameTorrent, hash, torrent.Name)
+	m.showRenameDialog = true
+}
+
+// handleRenameRequest opens the rename dialog for a file or folder
+func (m *MainView) handleRenameRequest(msg components.RenameRequestMsg) {
+	target := RenameFile
+	if msg.IsDir {
+		target = RenameFolder
+	}
+	m.renameDialog = NewRenameDialog(target, msg.Hash, msg.Path)
+	m.showRenameDialog = true
+}
+
+// closeRenameDialog closes the rename dialog and clears its state
+func (m *MainView) closeRenameDialog() {
+	m.showRenameDialog = false
+	m.renameDialog = nil
+}
+
+// handleRenameDialogKeys handles keyboard input for the rename dialog
+func (m *MainView) handleRenameDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.renameDialog
+
+	switch keyMsg.String() {
+	case "esc":
+		m.closeRenameDialog()
+	case "enter":
+		return m.confirmRename()
+	case "backspace":
+		d.input = deleteLastRune(d.input)
+	case "ctrl+u":
+		d.input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			d.input = appendPrintable(d.input, keyMsg.Text)
+		}
+	}
+
+	return nil
+}
+
+// confirmRename validates the input and renames the target
+func (m *MainView) confirmRename() tea.Cmd {
+	d := m.renameDialog
+	if err := validateRename(d.target, d.input); err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+
+	target, hash, oldPath, newPath := d.target, d.hash, d.oldPath, d.newPath()
+	m.closeRenameDialog()
+	if newPath == oldPath {
+		return nil
+	}
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		switch target {
+		case RenameFile:
+			if err := m.apiClient.RenameFile(ctx, hash, oldPath, newPath); err != nil {
+				return components.DetailsActionMsg{Err: fmt.Errorf("failed to rename file: %w", err)}
+			}
+			return components.DetailsActionMsg{Success: fmt.Sprintf("renamed file: %s", path.Base(newPath))}
+		case RenameFolder:
+			if err := m.apiClient.RenameFolder(ctx, hash, oldPath, newPath); err != nil {
+				return components.DetailsActionMsg{Err: fmt.Errorf("failed to rename folder: %w", err)}
+			}
+			return components.DetailsActionMsg{Success: fmt.Sprintf("renamed folder: %s", path.Base(newPath))}
+		default:
+			if err := m.apiClient.RenameTorrent(ctx, hash, newPath); err != nil {
+				return errorMsg(fmt.Errorf("failed to rename torrent: %w", err))
+			}
+			return successMsg(fmt.Sprintf("renamed torrent: %s", styles.TruncateString(newPath, 40)))
+		}
+	}
+}
+
+// renderRenameDialog renders the rename dialog
+func (m *MainView) renderRenameDialog() string {
+	d := m.renameDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	var title, current string
+	switch d.target {
+	case RenameFile:
+		title = "Rename File"
+		current = fmt.Sprintf("File: %s", styles.TruncateString(d.oldPath, 50))
+	case RenameFolder:
+		title = "Rename Folder"
+		current = fmt.Sprintf("Folder: %s", styles.TruncateString(d.oldPath, 50))
+	default:
+		title = "Rename Torrent"
+		current = fmt.Sprintf("Torrent: %s", styles.TruncateString(d.oldPath, 50))
+	}
+
+	input := styles.FocusedInputStyle.Width(60).Render(styles.TextStyle.Render(d.input + "▊"))
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		styles.AccentStyle.Render(title),
+		styles.DimStyle.Render(current),
+		"",
+		input,
+		"",
+		styles.DimStyle.Render("Enter: Rename  Ctrl+U: Clear  Esc: Cancel"),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
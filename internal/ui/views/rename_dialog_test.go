@@ -0,0 +1,51 @@
+package views
+
+import "testing"
+
+func TestRenameDialogNewPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  RenameTarget
+		oldPath string
+		input   string
+		want    string
+	}{
+		{"torrent keeps full name", RenameTorrent, "Some/Torrent", "New Name", "New Name"},
+		{"file in folder keeps parent", RenameFile, "Show/S01/E01.mkv", "Pilot.mkv", "Show/S01/Pilot.mkv"},
+		{"root file", RenameFile, "movie.mkv", "film.mkv", "film.mkv"},
+		{"folder", RenameFolder, "Show/S01", " Season 1 ", "Show/Season 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewRenameDialog(tt.target, "hash", tt.oldPath)
+			d.input = tt.input
+			if got := d.newPath(); got != tt.want {
+				t.Errorf("newPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if d := NewRenameDialog(RenameFile, "hash", "Show/S01/E01.mkv"); d.input != "E01.mkv" {
+		t.Errorf("file rename should be prefilled with the base name, got %q", d.input)
+	}
+}
+
+func TestValidateRename(t *testing.T) {
+	tests := []struct {
+		target  RenameTarget
+		name    string
+		wantErr bool
+	}{
+		{RenameTorrent, "Name with / slash", false},
+		{RenameTorrent, "   ", true},
+		{RenameFile, "episode.mkv", false},
+		{RenameFile, "sub/episode.mkv", true},
+		{RenameFolder, `back\slash`, true},
+		{RenameFolder, "..", true},
+	}
+	for _, tt := range tests {
+		if err := validateRename(tt.target, tt.name); (err != nil) != tt.wantErr {
+			t.Errorf("validateRename(%d, %q) error = %v, wantErr %v", tt.target, tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,1045 @@
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// Message types for the RSS view
+type (
+	rssDataMsg struct {
+		feeds []api.RSSFeed
+		rules map[string]api.RSSRule
+	}
+	rssMatchesMsg struct {
+		rule    string
+		matches map[string][]string // Article titles by feed name
+	}
+	rssUpdatedMsg   string // Success message after changing feeds or rules
+	rssRuleSavedMsg string // Name of the rule saved from the editor
+)
+
+// rssRefreshInterval is how often the open RSS view reloads feeds and rules;
+// every reload sends all articles, and the server only refreshes feeds every
+// few minutes anyway
+const rssRefreshInterval = 30 * time.Second
+
+// rssTab is a tab of the RSS view
+type rssTab int
+
+const (
+	rssTabFeeds rssTab = iota
+	rssTabRules
+)
+
+// rssPrompt is the single-line input open in the RSS view, if any
+type rssPrompt int
+
+const (
+	rssPromptNone rssPrompt = iota
+	rssPromptAddFeed
+	rssPromptNewRule
+)
+
+// RSSView holds the RSS view state: feeds with their articles, and the
+// auto-download rules
+type RSSView struct {
+	tab       rssTab
+	loaded    bool
+	fetchedAt time.Time // When feeds and rules were last requested
+	feeds     []api.RSSFeed
+	rules     map[string]api.RSSRule
+	ruleNames []string // Sorted
+
+	feedCursor      int
+	articleCursor   int
+	articlesFocused bool
+	ruleCursor      int
+
+	// Articles the server matches for the rule under the cursor
+	matchesRule string
+	matches     map[string][]string
+
+	prompt        rssPrompt
+	input         string
+	confirmRemove bool // Confirming removal of the feed under the cursor
+
+	editor *RSSRuleEditor
+}
+
+// selectedFeed returns the feed under the cursor
+func (v *RSSView) selectedFeed() *api.RSSFeed {
+	if v.feedCursor < 0 || v.feedCursor >= len(v.feeds) {
+		return nil
+	}
+	return &v.feeds[v.feedCursor]
+}
+
+// selectedArticle returns the article under the cursor
+func (v *RSSView) selectedArticle() *api.RSSArticle {
+	feed := v.selectedFeed()
+	if feed == nil || v.articleCursor < 0 || v.articleCursor >= len(feed.Articles) {
+		return nil
+	}
+	return &feed.Articles[v.articleCursor]
+}
+
+// selectedRule returns the name of the rule under the cursor
+func (v *RSSView) selectedRule() string {
+	if v.ruleCursor < 0 || v.ruleCursor >= len(v.ruleNames) {
+		return ""
+	}
+	return v.ruleNames[v.ruleCursor]
+}
+
+// setData replaces the feeds and rules, keeping the cursors on the same feed,
+// article and rule
+func (v *RSSView) setData(feeds []api.RSSFeed, rules map[string]api.RSSRule) {
+	var feedPath, articleID string
+	if feed := v.selectedFeed(); feed != nil {
+		feedPath = feed.Path
+	}
+	if article := v.selectedArticle(); article != nil {
+		articleID = article.ID
+	}
+	ruleName := v.selectedRule()
+
+	v.feeds = feeds
+	v.rules = rules
+	v.ruleNames = make([]string, 0, len(rules))
+	for name := range rules {
+		v.ruleNames = append(v.ruleNames, name)
+	}
+	sort.Strings(v.ruleNames)
+	v.loaded = true
+
+	if i := slices.IndexFunc(feeds, func(f api.RSSFeed) bool { return f.Path == feedPath }); i >= 0 {
+		v.feedCursor = i
+	}
+	v.feedCursor = max(min(v.feedCursor, len(feeds)-1), 0)
+	if feed := v.selectedFeed(); feed != nil {
+		if i := slices.IndexFunc(feed.Articles, func(a api.RSSArticle) bool { return a.ID == articleID }); i >= 0 {
+			v.articleCursor = i
+		}
+		v.articleCursor = max(min(v.articleCursor, len(feed.Articles)-1), 0)
+	} else {
+		v.articleCursor = 0
+		v.articlesFocused = false
+	}
+	if i := slices.Index(v.ruleNames, ruleName); i >= 0 {
+		v.ruleCursor = i
+	}
+	v.ruleCursor = max(min(v.ruleCursor, len(v.ruleNames)-1), 0)
+}
+
+// rssFeedName returns the name the server uses for a feed: the last part of its path
+func rssFeedName(feed api.RSSFeed) string {
+	return feed.Path[strings.LastIndex(feed.Path, api.RSSPathSeparator)+1:]
+}
+
+// rssFeedLabel returns how a feed is listed: its path with folders
+func rssFeedLabel(feed api.RSSFeed) string {
+	return strings.ReplaceAll(feed.Path, api.RSSPathSeparator, " › ")
+}
+
+// rssRuleField is one setting in the rule editor. Switches set toggle;
+// everything else is edited as text and sets parse.
+type rssRuleField struct {
+	section string
+	label   string
+	hint    string // Accepted input, shown while editing
+	format  func(r *api.RSSRule) string
+	toggle  func(r *api.RSSRule)
+	parse   func(r *api.RSSRule, input string) error
+}
+
+// boolRuleField is an on/off switch
+func boolRuleField(label string, value func(*api.RSSRule) *bool) rssRuleField {
+	return rssRuleField{
+		section: "Rule",
+		label:   label,
+		format: func(r *api.RSSRule) string {
+			if *value(r) {
+				return "✓ on"
+			}
+			return "✗ off"
+		},
+		toggle: func(r *api.RSSRule) { *value(r) = !*value(r) },
+	}
+}
+
+// textRuleField is free text
+func textRuleField(label, hint string, value func(*api.RSSRule) *string) rssRuleField {
+	return rssRuleField{
+		section: "Rule",
+		label:   label,
+		hint:    hint,
+		format:  func(r *api.RSSRule) string { return *value(r) },
+		parse: func(r *api.RSSRule, input string) error {
+			*value(r) = strings.TrimSpace(input)
+			return nil
+		},
+	}
+}
+
+// rssRuleFields lists the rule editor's settings, followed by a switch for
+// each feed the rule can apply to
+func rssRuleFields(feeds []api.RSSFeed) []rssRuleField {
+	fields := []rssRuleField{
+		boolRuleField("Enabled", func(r *api.RSSRule) *bool { return &r.Enabled }),
+		textRuleField("Must contain", "Words that must all appear; | separates alternatives, * and ? are wildcards", func(r *api.RSSRule) *string { return &r.MustContain }),
+		textRuleField("Must not contain", "Words that exclude an article; | separates alternatives", func(r *api.RSSRule) *string { return &r.MustNotContain }),
+		boolRuleField("Use regular expressions", func(r *api.RSSRule) *bool { return &r.UseRegex }),
+		textRuleField("Episode filter", "e.g. 1x2;8-15;20-, checked by the server", func(r *api.RSSRule) *string { return &r.EpisodeFilter }),
+		boolRuleField("Smart episode filter", func(r *api.RSSRule) *bool { return &r.SmartFilter }),
+		textRuleField("Save to", "Path on the qBittorrent server, empty for the default", func(r *api.RSSRule) *string { return &r.SavePath }),
+		textRuleField("Category", "Category assigned to downloads, empty for none", func(r *api.RSSRule) *string { return &r.AssignedCategory }),
+		{
+			section: "Rule",
+			label:   "Ignore matches for",
+			hint:    "Days to ignore later matches after a download, 0 to never ignore",
+			format: func(r *api.RSSRule) string {
+				if r.IgnoreDays == 0 {
+					return "never"
+				}
+				return fmt.Sprintf("%d days", r.IgnoreDays)
+			},
+			parse: func(r *api.RSSRule, input string) error {
+				input = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(input), "days"))
+				if input == "never" {
+					input = "0"
+				}
+				days, err := strconv.Atoi(input)
+				if err != nil || days < 0 {
+					return fmt.Errorf("ignore days must be a whole number of days, 0 or more")
+				}
+				r.IgnoreDays = days
+				return nil
+			},
+		},
+	}
+
+	for _, feed := range feeds {
+		feedURL := feed.URL
+		fields = append(fields, rssRuleField{
+			section: "Apply to feeds",
+			label:   rssFeedLabel(feed),
+			format: func(r *api.RSSRule) string {
+				if slices.Contains(r.AffectedFeeds, feedURL) {
+					return "✓ applies"
+				}
+				return "✗"
+			},
+			toggle: func(r *api.RSSRule) {
+				if i := slices.Index(r.AffectedFeeds, feedURL); i >= 0 {
+					r.AffectedFeeds = slices.Delete(slices.Clone(r.AffectedFeeds), i, i+1)
+				} else {
+					r.AffectedFeeds = append(slices.Clone(r.AffectedFeeds), feedURL)
+				}
+			},
+		})
+	}
+	return fields
+}
+
+// RSSRuleEditor holds the state of the auto-download rule editor
+type RSSRuleEditor struct {
+	name     string
+	isNew    bool
+	original api.RSSRule
+	rule     api.RSSRule
+	fields   []rssRuleField
+
+	cursor  int
+	editing bool
+	input   string
+	err     error // Why the typed value was rejected
+
+	confirmDiscard bool // Esc pressed once with unsaved changes
+}
+
+// newRSSRuleEditor starts editing a copy of a rule
+func newRSSRuleEditor(name string, rule api.RSSRule, isNew bool, feeds []api.RSSFeed) *RSSRuleEditor {
+	return &RSSRuleEditor{
+		name:     name,
+		isNew:    isNew,
+		original: rule,
+		rule:     rule,
+		fields:   rssRuleFields(feeds),
+	}
+}
+
+// changed reports whether the rule differs from what the server has
+func (e *RSSRuleEditor) changed() bool {
+	if e.isNew {
+		return true
+	}
+	before, _ := json.Marshal(e.original)
+	after, _ := json.Marshal(e.rule)
+	return string(before) != string(after)
+}
+
+// previewRule returns the rule as it would be with the value being typed
+func (e *RSSRuleEditor) previewRule() api.RSSRule {
+	rule := e.rule
+	if e.editing {
+		_ = e.fields[e.cursor].parse(&rule, e.input)
+	}
+	return rule
+}
+
+// rssMatch is an article an auto-download rule matches
+type rssMatch struct {
+	feed  string
+	title string
+}
+
+// rssRuleMatches returns the loaded articles a rule would download
+func rssRuleMatches(rule api.RSSRule, feeds []api.RSSFeed) ([]rssMatch, error) {
+	var matches []rssMatch
+	for _, feed := range feeds {
+		if !slices.Contains(rule.AffectedFeeds, feed.URL) {
+			continue
+		}
+		for _, article := range feed.Articles {
+			ok, err := rule.Matches(article.Title)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matches = append(matches, rssMatch{feed: rssFeedName(feed), title: article.Title})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// handleOpenRSS switches to the RSS view and loads feeds and rules
+func (m *MainView) handleOpenRSS() tea.Cmd {
+	m.viewMode = ViewModeRSS
+	m.rss = &RSSView{}
+	return m.fetchRSS()
+}
+
+// closeRSS returns to the torrent list
+func (m *MainView) closeRSS() {
+	m.viewMode = ViewModeMain
+	m.rss = nil
+}
+
+// fetchRSS loads the feeds, with their articles, and the auto-download rules
+// while the RSS view is open
+func (m *MainView) fetchRSS() tea.Cmd {
+	if m.rss == nil {
+		return nil
+	}
+	m.rss.fetchedAt = time.Now()
+	return func() tea.Msg {
+		ctx := context.Background()
+		feeds, err := m.apiClient.GetRSSItems(ctx, true)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load RSS feeds: %w", err))
+		}
+		rules, err := m.apiClient.GetRSSRules(ctx)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load RSS rules: %w", err))
+		}
+		return rssDataMsg{feeds: feeds, rules: rules}
+	}
+}
+
+// fetchRSSMatches asks the server which articles the rule under the cursor matches
+func (m *MainView) fetchRSSMatches() tea.Cmd {
+	name := m.rss.selectedRule()
+	if name == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		matches, err := m.apiClient.GetRSSMatchingArticles(context.Background(), name)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load matching articles: %w", err))
+		}
+		return rssMatchesMsg{rule: name, matches: matches}
+	}
+}
+
+// handleRSSData shows freshly loaded feeds and rules
+func (m *MainView) handleRSSData(msg rssDataMsg) tea.Cmd {
+	if m.viewMode != ViewModeRSS || m.rss == nil {
+		return nil
+	}
+	m.rss.setData(msg.feeds, msg.rules)
+	if m.rss.tab == rssTabRules && m.rss.editor == nil {
+		return m.fetchRSSMatches()
+	}
+	return nil
+}
+
+// handleRSSMatches shows the server's matches if the rule is still selected
+func (m *MainView) handleRSSMatches(msg rssMatchesMsg) {
+	if m.rss == nil || m.rss.selectedRule() != msg.rule {
+		return
+	}
+	m.rss.matchesRule = msg.rule
+	m.rss.matches = msg.matches
+}
+
+// rssAction runs a change against the server and reloads the RSS view
+func (m *MainView) rssAction(action, success string, fn func(ctx context.Context) error) tea.Cmd {
+	return func() tea.Msg {
+		if err := fn(context.Background()); err != nil {
+			return errorMsg(fmt.Errorf("failed to %s: %w", action, err))
+		}
+		return rssUpdatedMsg(success)
+	}
+}
+
+// handleRSSKeys handles keyboard input in the RSS view
+func (m *MainView) handleRSSKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.rss
+	switch {
+	case v.editor != nil:
+		return m.handleRSSRuleEditorKeys(keyMsg)
+	case v.prompt != rssPromptNone:
+		return m.handleRSSPromptKeys(keyMsg)
+	case v.confirmRemove:
+		v.confirmRemove = false
+		feed := v.selectedFeed()
+		switch keyMsg.String() {
+		case "y", "Y", "enter":
+			if feed != nil {
+				path := feed.Path
+				return m.rssAction("remove feed", "removed feed: "+rssFeedLabel(*feed), func(ctx context.Context) error {
+					return m.apiClient.RemoveRSSItem(ctx, path)
+				})
+			}
+		}
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		if v.tab == rssTabFeeds && v.articlesFocused {
+			v.articlesFocused = false
+			return nil
+		}
+		m.closeRSS()
+		return nil
+	case "tab", "shift+tab":
+		if v.tab == rssTabFeeds {
+			v.tab = rssTabRules
+			return m.fetchRSSMatches()
+		}
+		v.tab = rssTabFeeds
+		return nil
+	}
+
+	if v.tab == rssTabRules {
+		return m.handleRSSRulesKeys(keyMsg)
+	}
+	if v.articlesFocused {
+		return m.handleRSSArticlesKeys(keyMsg)
+	}
+	return m.handleRSSFeedsKeys(keyMsg)
+}
+
+// moveRSSCursor moves a list cursor for the navigation keys, reporting
+// whether the key was one of them
+func moveRSSCursor(cursor *int, count int, key string) bool {
+	switch key {
+	case "up", "k":
+		if *cursor > 0 {
+			*cursor--
+		}
+	case "down", "j":
+		if *cursor < count-1 {
+			*cursor++
+		}
+	case "g":
+		*cursor = 0
+	case "G":
+		*cursor = max(count-1, 0)
+	default:
+		return false
+	}
+	return true
+}
+
+// handleRSSFeedsKeys handles keys while the feed list is focused
+func (m *MainView) handleRSSFeedsKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.rss
+	if moveRSSCursor(&v.feedCursor, len(v.feeds), keyMsg.String()) {
+		v.articleCursor = 0
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "a":
+		v.prompt = rssPromptAddFeed
+		v.input = ""
+		return nil
+	case "R":
+		return m.rssAction("refresh feeds", "refreshing all feeds", func(ctx context.Context) error {
+			return m.apiClient.RefreshRSSItem(ctx, "")
+		})
+	}
+
+	feed := v.selectedFeed()
+	if feed == nil {
+		return nil
+	}
+	path, label := feed.Path, rssFeedLabel(*feed)
+
+	switch keyMsg.String() {
+	case "enter", "right", "l":
+		if len(feed.Articles) > 0 {
+			v.articlesFocused = true
+		}
+	case "d":
+		v.confirmRemove = true
+	case "r":
+		return m.rssAction("refresh feed", "refreshing feed: "+label, func(ctx context.Context) error {
+			return m.apiClient.RefreshRSSItem(ctx, path)
+		})
+	case "m":
+		return m.rssAction("mark feed as read", "marked as read: "+label, func(ctx context.Context) error {
+			return m.apiClient.MarkRSSAsRead(ctx, path, "")
+		})
+	}
+	return nil
+}
+
+// handleRSSArticlesKeys handles keys while the article list is focused
+func (m *MainView) handleRSSArticlesKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.rss
+	feed := v.selectedFeed()
+	if feed == nil {
+		v.articlesFocused = false
+		return nil
+	}
+	if moveRSSCursor(&v.articleCursor, len(feed.Articles), keyMsg.String()) {
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "left", "h":
+		v.articlesFocused = false
+		return nil
+	}
+
+	article := v.selectedArticle()
+	if article == nil {
+		return nil
+	}
+	path, id, title := feed.Path, article.ID, article.Title
+
+	switch keyMsg.String() {
+	case "enter", "d":
+		downloadURL := article.DownloadURL()
+		if downloadURL == "" {
+			return func() tea.Msg {
+				return errorMsg(fmt.Errorf("article has no torrent or link to download"))
+			}
+		}
+		return m.rssAction("download article", "added torrent: "+title, func(ctx context.Context) error {
+			return m.apiClient.AddTorrentURL(ctx, downloadURL, api.AddTorrentOptions{})
+		})
+	case "m":
+		return m.rssAction("mark article as read", "marked as read: "+title, func(ctx context.Context) error {
+			return m.apiClient.MarkRSSAsRead(ctx, path, id)
+		})
+	}
+	return nil
+}
+
+// handleRSSRulesKeys handles keys on the Rules tab
+func (m *MainView) handleRSSRulesKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.rss
+	if moveRSSCursor(&v.ruleCursor, len(v.ruleNames), keyMsg.String()) {
+		if v.selectedRule() != v.matchesRule {
+			v.matches = nil
+			return m.fetchRSSMatches()
+		}
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "a":
+		v.prompt = rssPromptNewRule
+		v.input = ""
+		return nil
+	}
+
+	name := v.selectedRule()
+	if name == "" {
+		return nil
+	}
+	rule := v.rules[name]
+
+	switch keyMsg.String() {
+	case "enter", "e":
+		v.editor = newRSSRuleEditor(name, rule, false, v.feeds)
+	case "space", " ":
+		rule.Enabled = !rule.Enabled
+		state := "disabled"
+		if rule.Enabled {
+			state = "enabled"
+		}
+		return m.rssAction("update rule", fmt.Sprintf("%s rule: %s", state, name), func(ctx context.Context) error {
+			return m.apiClient.SetRSSRule(ctx, name, rule)
+		})
+	}
+	return nil
+}
+
+// handleRSSPromptKeys handles typing a feed URL or a new rule name
+func (m *MainView) handleRSSPromptKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.rss
+	switch keyMsg.String() {
+	case "esc":
+		v.prompt = rssPromptNone
+		v.input = ""
+	case "enter":
+		input := strings.TrimSpace(v.input)
+		if input == "" {
+			return nil
+		}
+		prompt := v.prompt
+		v.prompt = rssPromptNone
+		v.input = ""
+
+		if prompt == rssPromptNewRule {
+			if _, exists := v.rules[input]; exists {
+				return func() tea.Msg {
+					return errorMsg(fmt.Errorf("rule %q already exists", input))
+				}
+			}
+			v.editor = newRSSRuleEditor(input, api.RSSRule{Enabled: true}, true, v.feeds)
+			return nil
+		}
+		return m.rssAction("add feed", "added feed: "+input, func(ctx context.Context) error {
+			return m.apiClient.AddRSSFeed(ctx, input, "")
+		})
+	case "backspace":
+		v.input = deleteLastRune(v.input)
+	case "ctrl+u":
+		v.input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			v.input = appendPrintable(v.input, keyMsg.Text)
+		}
+	}
+	return nil
+}
+
+// handleRSSRuleEditorKeys handles keyboard input in the rule editor
+func (m *MainView) handleRSSRuleEditorKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	e := m.rss.editor
+	field := e.fields[e.cursor]
+
+	if e.editing {
+		switch keyMsg.String() {
+		case "esc":
+			e.editing = false
+			e.err = nil
+		case "enter":
+			if err := field.parse(&e.rule, e.input); err != nil {
+				e.err = err
+				return nil
+			}
+			e.editing = false
+			e.err = nil
+		case "backspace":
+			e.input = deleteLastRune(e.input)
+		case "ctrl+u":
+			e.input = ""
+		default:
+			if len(keyMsg.Text) > 0 {
+				e.input = appendPrintable(e.input, keyMsg.Text)
+			}
+		}
+		return nil
+	}
+
+	if keyMsg.String() != "esc" {
+		e.confirmDiscard = false
+	}
+	if moveRSSCursor(&e.cursor, len(e.fields), keyMsg.String()) {
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		if e.changed() && !e.confirmDiscard {
+			e.confirmDiscard = true
+			return nil
+		}
+		m.rss.editor = nil
+		return m.fetchRSSMatches()
+	case "enter", "space", " ":
+		if field.toggle != nil {
+			field.toggle(&e.rule)
+		} else {
+			e.editing = true
+			e.input = field.format(&e.rule)
+			e.err = nil
+		}
+	case "u":
+		e.rule = e.original
+	case "s":
+		return m.saveRSSRule()
+	}
+	return nil
+}
+
+// saveRSSRule checks the rule's expressions and saves it
+func (m *MainView) saveRSSRule() tea.Cmd {
+	e := m.rss.editor
+	name, rule := e.name, e.rule
+	if _, err := rule.Matches(""); err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+	if len(rule.AffectedFeeds) == 0 {
+		return func() tea.Msg {
+			return errorMsg(fmt.Errorf("select at least one feed for the rule to apply to"))
+		}
+	}
+
+	return func() tea.Msg {
+		if err := m.apiClient.SetRSSRule(context.Background(), name, rule); err != nil {
+			return errorMsg(fmt.Errorf("failed to save rule: %w", err))
+		}
+		return rssRuleSavedMsg(name)
+	}
+}
+
+// handleRSSRuleSaved closes the editor on the saved rule
+func (m *MainView) handleRSSRuleSaved(name string) {
+	if m.rss == nil {
+		return
+	}
+	m.rss.editor = nil
+	m.rss.matchesRule = ""
+	m.rss.matches = nil
+	// Select the rule once it's reloaded
+	if !slices.Contains(m.rss.ruleNames, name) {
+		m.rss.ruleNames = append(m.rss.ruleNames, name)
+		sort.Strings(m.rss.ruleNames)
+	}
+	m.rss.ruleCursor = slices.Index(m.rss.ruleNames, name)
+}
+
+// renderRSSView renders the RSS view in place of the torrent list
+func (m *MainView) renderRSSView() string {
+	helpHeight := strings.Count(m.help.View(m.keys), "\n") + 1
+	contentHeight := m.height - helpHeight - 1
+	innerWidth := max(m.width-6, 20)      // Panel borders and padding
+	bodyHeight := max(contentHeight-4, 1) // Panel borders and tab bar
+
+	var content string
+	switch v := m.rss; {
+	case v == nil || !v.loaded:
+		content = styles.DimStyle.Render("Loading RSS feeds...")
+	case v.editor != nil:
+		content = m.renderRSSRuleEditor(innerWidth, bodyHeight+2)
+	default:
+		var body string
+		if v.tab == rssTabRules {
+			body = v.renderRulesTab(innerWidth, bodyHeight)
+		} else {
+			body = v.renderFeedsTab(innerWidth, bodyHeight)
+		}
+		content = lipgloss.JoinVertical(lipgloss.Left, v.renderTabBar(), "", body)
+	}
+	panel := styles.FocusedPanelStyle.Width(m.width).Height(contentHeight).Render(content)
+
+	// Status line at the bottom - priority: error (red) > success (green) > help
+	var statusView string
+	if m.lastError != nil {
+		statusView = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.lastError))
+	} else if m.lastSuccess != "" {
+		statusView = styles.SuccessStyle.Render(fmt.Sprintf("✓ %s", m.lastSuccess))
+	} else {
+		statusView = m.renderRSSHelp()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, panel, statusView)
+}
+
+// renderRSSHelp renders the key hints for the current RSS view state
+func (m *MainView) renderRSSHelp() string {
+	v := m.rss
+	switch {
+	case v == nil || !v.loaded:
+		return styles.DimStyle.Render("Esc back")
+	case v.editor != nil && v.editor.editing:
+		return styles.DimStyle.Render("Enter apply • Ctrl+U clear • Esc cancel")
+	case v.editor != nil && v.editor.confirmDiscard:
+		return styles.WarningStyle.Render("Unsaved changes - press Esc again to discard them, or s to save")
+	case v.editor != nil:
+		return styles.DimStyle.Render("↑↓ select • Enter edit/toggle • u revert • s save • Esc back")
+	case v.prompt != rssPromptNone:
+		return styles.DimStyle.Render("Enter confirm • Ctrl+U clear • Esc cancel")
+	case v.confirmRemove:
+		return styles.WarningStyle.Render("Remove this feed? Y/Enter remove • any other key cancels")
+	case v.tab == rssTabRules:
+		return styles.DimStyle.Render("↑↓ select • a new rule • Enter edit • Space enable/disable • Tab feeds • Esc back")
+	case v.articlesFocused:
+		return styles.DimStyle.Render("↑↓ select • Enter download • m mark read • ←/Esc feeds • Tab rules")
+	}
+	return styles.DimStyle.Render("↑↓ select • Enter articles • a add • d remove • r refresh • R refresh all • m mark read • Tab rules • Esc back")
+}
+
+// renderTabBar renders the Feeds/Rules tab switcher
+func (v *RSSView) renderTabBar() string {
+	var tabs []string
+	for i, name := range []string{"Feeds", "Rules"} {
+		if rssTab(i) == v.tab {
+			tabs = append(tabs, styles.SelectedRowStyle.Render(fmt.Sprintf("[%s]", name)))
+		} else {
+			tabs = append(tabs, styles.DimStyle.Render(fmt.Sprintf(" %s ", name)))
+		}
+	}
+	return strings.Join(tabs, " ")
+}
+
+// renderFeedsTab renders the feed list next to the articles of the selected feed
+func (v *RSSView) renderFeedsTab(width, height int) string {
+	leftWidth, rightWidth := rssColumnWidths(width)
+
+	var feedLines []string
+	if v.prompt == rssPromptAddFeed {
+		height -= 2
+	}
+	for i, feed := range v.feeds {
+		status := fmt.Sprintf(" (%d)", feed.UnreadCount())
+		switch {
+		case feed.IsLoading:
+			status = " ⟳"
+		case feed.HasError:
+			status = " ✗"
+		}
+		line := styles.TruncateString(rssFeedLabel(feed), leftWidth-len([]rune(status))-2) + status
+		feedLines = append(feedLines, listRow(line, i == v.feedCursor, !v.articlesFocused))
+	}
+	if len(feedLines) == 0 {
+		feedLines = append(feedLines, styles.DimStyle.Render("No feeds - press a to add one"))
+	}
+
+	var articleLines []string
+	detailHeight := 0
+	if feed := v.selectedFeed(); feed != nil {
+		for i, article := range feed.Articles {
+			marker := "  "
+			if !article.IsRead {
+				marker = "● "
+			}
+			line := marker + styles.TruncateString(article.Title, rightWidth-4)
+			articleLines = append(articleLines, listRow(line, i == v.articleCursor, v.articlesFocused))
+		}
+		if len(articleLines) == 0 {
+			articleLines = append(articleLines, styles.DimStyle.Render("No articles"))
+		}
+		if article := v.selectedArticle(); article != nil && v.articlesFocused {
+			details := v.renderArticleDetails(article, rightWidth)
+			detailHeight = strings.Count(details, "\n") + 2
+			articleLines = scrollWindow(articleLines, v.articleCursor, height-detailHeight)
+			articleLines = append(articleLines, "", details)
+		}
+	}
+	if detailHeight == 0 {
+		articleLines = scrollWindow(articleLines, v.articleCursor, height)
+	}
+
+	columns := rssColumns(
+		strings.Join(scrollWindow(feedLines, v.feedCursor, height), "\n"),
+		strings.Join(articleLines, "\n"),
+		leftWidth, rightWidth, height,
+	)
+	if v.prompt == rssPromptAddFeed {
+		columns = lipgloss.JoinVertical(lipgloss.Left, columns, "",
+			"Feed URL: "+styles.TextStyle.Render(v.input+"▊"))
+	}
+	return columns
+}
+
+// renderArticleDetails renders the date and links of an article
+func (v *RSSView) renderArticleDetails(article *api.RSSArticle, width int) string {
+	lines := []string{styles.TitleStyle.Render(styles.TruncateString(article.Title, width))}
+	if article.Date != "" {
+		lines = append(lines, styles.DimStyle.Render("Date: ")+article.Date)
+	}
+	if article.TorrentURL != "" {
+		lines = append(lines, styles.DimStyle.Render("Torrent: ")+styles.TruncateString(article.TorrentURL, width-9))
+	}
+	if article.Link != "" && article.Link != article.TorrentURL {
+		lines = append(lines, styles.DimStyle.Render("Link: ")+styles.TruncateString(article.Link, width-6))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderRulesTab renders the rule list next to the articles the server
+// matches for the selected rule
+func (v *RSSView) renderRulesTab(width, height int) string {
+	leftWidth, rightWidth := rssColumnWidths(width)
+	if v.prompt == rssPromptNewRule {
+		height -= 2
+	}
+
+	var ruleLines []string
+	for i, name := range v.ruleNames {
+		marker := "✗ "
+		if v.rules[name].Enabled {
+			marker = "✓ "
+		}
+		ruleLines = append(ruleLines, listRow(marker+styles.TruncateString(name, leftWidth-4), i == v.ruleCursor, true))
+	}
+	if len(ruleLines) == 0 {
+		ruleLines = append(ruleLines, styles.DimStyle.Render("No rules - press a to add one"))
+	}
+
+	var matchLines []string
+	if name := v.selectedRule(); name != "" {
+		matchLines = append(matchLines, styles.SubtitleStyle.Render("Matching articles"))
+		switch {
+		case v.matchesRule != name:
+			matchLines = append(matchLines, styles.DimStyle.Render("Loading..."))
+		case len(v.matches) == 0:
+			matchLines = append(matchLines, styles.DimStyle.Render("No articles match this rule"))
+		default:
+			feedNames := make([]string, 0, len(v.matches))
+			for feedName := range v.matches {
+				feedNames = append(feedNames, feedName)
+			}
+			sort.Strings(feedNames)
+			for _, feedName := range feedNames {
+				matchLines = append(matchLines, styles.AccentStyle.Render(styles.TruncateString(feedName, rightWidth)))
+				for _, title := range v.matches[feedName] {
+					matchLines = append(matchLines, "  "+styles.TruncateString(title, rightWidth-2))
+				}
+			}
+		}
+	}
+	if len(matchLines) > height {
+		matchLines = matchLines[:height]
+	}
+
+	columns := rssColumns(
+		strings.Join(scrollWindow(ruleLines, v.ruleCursor, height), "\n"),
+		strings.Join(matchLines, "\n"),
+		leftWidth, rightWidth, height,
+	)
+	if v.prompt == rssPromptNewRule {
+		columns = lipgloss.JoinVertical(lipgloss.Left, columns, "",
+			"Rule name: "+styles.TextStyle.Render(v.input+"▊"))
+	}
+	return columns
+}
+
+// renderRSSRuleEditor renders the rule settings next to a live preview of
+// the loaded articles the rule would download
+func (m *MainView) renderRSSRuleEditor(width, height int) string {
+	e := m.rss.editor
+	leftWidth, rightWidth := rssColumnWidths(width)
+	labelWidth := min(24, leftWidth/2)
+
+	title := "Edit rule: " + e.name
+	if e.isNew {
+		title = "New rule: " + e.name
+	}
+	height -= 2 // Title
+
+	var fieldLines []string
+	cursorLine := 0
+	section := ""
+	for i, field := range e.fields {
+		if field.section != section {
+			if section != "" {
+				fieldLines = append(fieldLines, "")
+			}
+			section = field.section
+			fieldLines = append(fieldLines, styles.SubtitleStyle.Render(section))
+		}
+		value := field.format(&e.rule)
+		if i == e.cursor && e.editing {
+			value = e.input + "▊"
+		}
+		label := styles.TruncateString(field.label, labelWidth)
+		line := fmt.Sprintf("%-*s %s", labelWidth, label, value)
+		if i == e.cursor {
+			cursorLine = len(fieldLines)
+		}
+		fieldLines = append(fieldLines, listRow(styles.TruncateString(line, leftWidth), i == e.cursor, true))
+		if i == e.cursor && e.editing {
+			hint := styles.DimStyle.Render(styles.TruncateString("  "+field.hint, leftWidth))
+			if e.err != nil {
+				hint = styles.ErrorStyle.Render(styles.TruncateString("  "+e.err.Error(), leftWidth))
+			}
+			fieldLines = append(fieldLines, hint)
+		}
+	}
+	if len(m.rss.feeds) == 0 {
+		fieldLines = append(fieldLines, "", styles.SubtitleStyle.Render("Apply to feeds"), styles.DimStyle.Render("No feeds - add one first"))
+	}
+
+	// Live preview of the rule against the articles loaded for its feeds
+	rule := e.previewRule()
+	matches, err := rssRuleMatches(rule, m.rss.feeds)
+	previewLines := []string{styles.SubtitleStyle.Render(fmt.Sprintf("Would download (%d)", len(matches)))}
+	switch {
+	case err != nil:
+		previewLines = append(previewLines, styles.ErrorStyle.Render(styles.TruncateString(err.Error(), rightWidth)))
+	case len(rule.AffectedFeeds) == 0:
+		previewLines = append(previewLines, styles.DimStyle.Render("Select the feeds this rule applies to"))
+	case len(matches) == 0:
+		previewLines = append(previewLines, styles.DimStyle.Render("No loaded articles match"))
+	}
+	if rule.EpisodeFilter != "" || rule.SmartFilter {
+		previewLines = append(previewLines, styles.DimStyle.Render(styles.TruncateString("Episode filters are applied by the server and aren't shown here", rightWidth)))
+	}
+	for _, match := range matches {
+		previewLines = append(previewLines, styles.TruncateString(match.title, rightWidth-len([]rune(match.feed))-3)+styles.DimStyle.Render(" · "+match.feed))
+	}
+	if len(previewLines) > height {
+		previewLines = previewLines[:height]
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		styles.TitleStyle.Render(title),
+		"",
+		rssColumns(
+			strings.Join(scrollWindow(fieldLines, cursorLine, height), "\n"),
+			strings.Join(previewLines, "\n"),
+			leftWidth, rightWidth, height,
+		),
+	)
+}
+
+// rssColumnWidths splits the width between the left list and the right pane
+func rssColumnWidths(width int) (int, int) {
+	left := max(width*2/5, 20)
+	return left, max(width-left-3, 10) // " │ " separator
+}
+
+// rssColumns lays out two panes side by side with a separator
+func rssColumns(left, right string, leftWidth, rightWidth, height int) string {
+	separator := styles.DimStyle.Render(strings.TrimSuffix(strings.Repeat(" │ \n", max(height, 1)), "\n"))
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(leftWidth).Render(left),
+		separator,
+		lipgloss.NewStyle().Width(rightWidth).Render(right),
+	)
+}
@@ -0,0 +1,155 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func newRSSTestView(t *testing.T) (*MainView, *api.MockClient) {
+	t.Helper()
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.RSSFeeds = []api.RSSFeed{
+		{Path: "Distros", URL: "https://distros.example/rss", Articles: []api.RSSArticle{
+			{ID: "1", Title: "debian-13.0-amd64.iso", TorrentURL: "https://distros.example/debian.torrent"},
+			{ID: "2", Title: "fedora-42-x86_64.iso", Link: "https://distros.example/fedora.torrent"},
+		}},
+		{Path: `Shows\Show`, URL: "https://shows.example/rss", Articles: []api.RSSArticle{
+			{ID: "3", Title: "Show.S01E01.1080p"},
+			{ID: "4", Title: "Show.S01E01.720p"},
+		}},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.width, m.height = 120, 30
+	m.Update(m.handleOpenRSS()())
+	if m.viewMode != ViewModeRSS || m.rss == nil || !m.rss.loaded {
+		t.Fatal("RSS feeds should load into their own view")
+	}
+	return m, mock
+}
+
+func TestRSSViewFeedsAndArticles(t *testing.T) {
+	m, mock := newRSSTestView(t)
+
+	// Download the second article of the first feed, then mark it read
+	pressKeys(m, "enter", "down")
+	m.Update(pressKeys(m, "enter")())
+	if len(mock.AddedURLs) != 1 || mock.AddedURLs[0] != "https://distros.example/fedora.torrent" {
+		t.Fatalf("article link should be added as a torrent, got %v", mock.AddedURLs)
+	}
+	if msg := pressKeys(m, "m")(); msg != rssUpdatedMsg("marked as read: fedora-42-x86_64.iso") {
+		t.Fatalf("unexpected result %v", msg)
+	}
+	if mock.RSSFeeds[0].Articles[0].IsRead || !mock.RSSFeeds[0].Articles[1].IsRead {
+		t.Error("only the selected article should be marked read")
+	}
+
+	// Back to the feed list, add a feed
+	pressKeys(m, "esc", "a")
+	m.Update(tea.PasteMsg{Content: "https://new.example/rss\n"})
+	m.Update(pressKeys(m, "enter")())
+	m.Update(m.fetchRSS()())
+	if len(m.rss.feeds) != 3 {
+		t.Fatalf("added feed should be listed, got %d feeds", len(m.rss.feeds))
+	}
+	if m.rss.selectedFeed().Path != "Distros" {
+		t.Error("cursor should stay on the selected feed after reloading")
+	}
+
+	// Remove the selected feed after confirming
+	pressKeys(m, "d")
+	if !m.rss.confirmRemove {
+		t.Fatal("removing a feed should ask for confirmation")
+	}
+	m.Update(pressKeys(m, "y")())
+	if len(mock.RSSFeeds) != 2 || mock.RSSFeeds[0].Path == "Distros" {
+		t.Errorf("feed should be removed, got %v", mock.RSSFeeds)
+	}
+
+	pressKeys(m, "esc")
+	if m.viewMode != ViewModeMain || m.rss != nil {
+		t.Error("esc on the feed list should return to the torrent list")
+	}
+}
+
+func TestRSSRefreshInterval(t *testing.T) {
+	m, _ := newRSSTestView(t)
+
+	// Refresh ticks only reload feeds once the RSS interval has passed
+	fetchedAt := m.rss.fetchedAt
+	m.Update(tickMsg{id: m.tickID})
+	if !m.rss.fetchedAt.Equal(fetchedAt) {
+		t.Error("feeds should not reload on every refresh tick")
+	}
+	m.rss.fetchedAt = time.Now().Add(-rssRefreshInterval)
+	m.Update(tickMsg{id: m.tickID})
+	if !m.rss.fetchedAt.After(fetchedAt) {
+		t.Error("feeds should reload once the RSS interval has passed")
+	}
+
+	m.closeRSS()
+	if m.fetchRSS() != nil {
+		t.Error("feeds should not load while the RSS view is closed")
+	}
+}
+
+func TestRSSRuleEditorLivePreview(t *testing.T) {
+	m, mock := newRSSTestView(t)
+
+	pressKeys(m, "tab", "a")
+	m.Update(tea.PasteMsg{Content: "Show 1080p"})
+	pressKeys(m, "enter")
+	e := m.rss.editor
+	if e == nil || !e.isNew || e.name != "Show 1080p" {
+		t.Fatal("a new rule should open in the editor")
+	}
+
+	// Apply the rule to the Shows feed
+	for e.fields[e.cursor].label != `Shows › Show` {
+		pressKeys(m, "down")
+	}
+	pressKeys(m, "enter", "g", "down")
+
+	// The preview updates while typing, before the value is applied
+	pressKeys(m, "enter", "s", "h", "o", "w")
+	if matches, _ := rssRuleMatches(e.previewRule(), m.rss.feeds); len(matches) != 2 {
+		t.Fatalf("both episodes should match while typing, got %v", matches)
+	}
+	pressKeys(m, " ", "1", "0", "8", "0", "p")
+	matches, _ := rssRuleMatches(e.previewRule(), m.rss.feeds)
+	if len(matches) != 1 || matches[0].title != "Show.S01E01.1080p" || matches[0].feed != "Show" {
+		t.Fatalf("only the 1080p episode should match, got %v", matches)
+	}
+	pressKeys(m, "enter")
+
+	// An invalid regular expression can't be saved
+	pressKeys(m, "down", "down", "enter", "k", "k", "enter", "(", "enter")
+	if !e.rule.UseRegex || e.rule.MustContain != "show 1080p(" {
+		t.Fatalf("unexpected rule %+v", e.rule)
+	}
+	if msg, ok := pressKeys(m, "s")().(errorMsg); !ok {
+		t.Fatalf("invalid regex should be rejected, got %v", msg)
+	}
+	pressKeys(m, "enter", "backspace", "enter", "down", "down", "enter")
+
+	m.Update(pressKeys(m, "s")())
+	if m.rss.editor != nil {
+		t.Fatal("editor should close after saving")
+	}
+	rule, ok := mock.RSSRules["Show 1080p"]
+	if !ok || !rule.Enabled || rule.MustContain != "show 1080p" || len(rule.AffectedFeeds) != 1 {
+		t.Fatalf("rule should be saved, got %+v", rule)
+	}
+
+	// The rules tab shows what the server matches for the saved rule
+	m.Update(m.fetchRSS()())
+	m.Update(m.fetchRSSMatches()())
+	if got := m.rss.matches["Show"]; len(got) != 1 || got[0] != "Show.S01E01.1080p" {
+		t.Errorf("server matches should be shown, got %v", m.rss.matches)
+	}
+}
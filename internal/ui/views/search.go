@@ -0,0 +1,630 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+const (
+	// searchPollInterval is how often a running search is asked for new results
+	searchPollInterval = time.Second
+	// searchMaxPollBackoff caps the delay between polls that keep failing
+	searchMaxPollBackoff = 30 * time.Second
+)
+
+// Message types for the search view
+type (
+	searchPluginsMsg        []api.SearchPlugin
+	searchPluginsUpdatedMsg string // Success message after enabling or disabling plugins
+	searchStartedMsg        int    // ID of the new search job
+	searchPollMsg           int    // ID of the search job to fetch new results for
+	searchResultsMsg        struct {
+		id      int
+		offset  int
+		results *api.SearchResults
+		err     error
+	}
+)
+
+// searchColumn is a column of the search results table
+type searchColumn int
+
+const (
+	searchColumnName searchColumn = iota
+	searchColumnSize
+	searchColumnSeeds
+	searchColumnLeechers
+	searchColumnEngine
+)
+
+// searchColumns lists the results table columns; a width of 0 takes the
+// remaining space
+var searchColumns = []struct {
+	title string
+	width int
+}{
+	{"Name", 0},
+	{"Size", 10},
+	{"Seeds", 9},
+	{"Peers", 9},
+	{"Engine", 14},
+}
+
+// allSearchCategories searches every category
+var allSearchCategories = api.SearchCategory{ID: "all", Name: "All categories"}
+
+// SearchView holds the search view state
+type SearchView struct {
+	query         string
+	editingQuery  bool
+	categoryIndex int
+
+	plugins         []api.SearchPlugin
+	selectedPlugins []string // Plugins to search, all enabled ones when empty
+	showPlugins     bool
+	pluginCursor    int
+
+	jobID        int // 0 before the first search
+	status       string
+	total        int
+	results      []api.SearchResult
+	pollFailures int // Consecutive failed polls, to back off
+
+	sortColumn searchColumn
+	sortDesc   bool
+	cursor     int
+}
+
+// newSearchView starts with the query input focused and results sorted by seeds
+func newSearchView() *SearchView {
+	return &SearchView{
+		editingQuery: true,
+		sortColumn:   searchColumnSeeds,
+		sortDesc:     true,
+	}
+}
+
+// categories returns the categories the plugins being searched support
+func (v *SearchView) categories() []api.SearchCategory {
+	categories := []api.SearchCategory{allSearchCategories}
+	for _, plugin := range v.plugins {
+		if !v.searchesPlugin(plugin) {
+			continue
+		}
+		for _, category := range plugin.SupportedCategories {
+			if !slices.ContainsFunc(categories, func(c api.SearchCategory) bool { return c.ID == category.ID }) {
+				categories = append(categories, category)
+			}
+		}
+	}
+	return categories
+}
+
+// category returns the selected category
+func (v *SearchView) category() api.SearchCategory {
+	categories := v.categories()
+	if v.categoryIndex >= len(categories) {
+		v.categoryIndex = 0
+	}
+	return categories[v.categoryIndex]
+}
+
+// searchesPlugin reports whether a search would use the plugin
+func (v *SearchView) searchesPlugin(plugin api.SearchPlugin) bool {
+	if len(v.selectedPlugins) > 0 {
+		return slices.Contains(v.selectedPlugins, plugin.Name)
+	}
+	return plugin.Enabled
+}
+
+// selectedResult returns the result under the cursor
+func (v *SearchView) selectedResult() *api.SearchResult {
+	if v.cursor < 0 || v.cursor >= len(v.results) {
+		return nil
+	}
+	return &v.results[v.cursor]
+}
+
+// sortResults orders the results by the sort column, keeping the cursor on
+// the same result
+func (v *SearchView) sortResults() {
+	var selectedURL string
+	if result := v.selectedResult(); result != nil {
+		selectedURL = result.FileURL
+	}
+
+	less := func(a, b api.SearchResult) bool {
+		switch v.sortColumn {
+		case searchColumnSize:
+			return a.FileSize < b.FileSize
+		case searchColumnSeeds:
+			return a.Seeders < b.Seeders
+		case searchColumnLeechers:
+			return a.Leechers < b.Leechers
+		case searchColumnEngine:
+			return strings.ToLower(a.EngineName) < strings.ToLower(b.EngineName)
+		}
+		return strings.ToLower(a.FileName) < strings.ToLower(b.FileName)
+	}
+	sort.SliceStable(v.results, func(i, j int) bool {
+		if v.sortDesc {
+			return less(v.results[j], v.results[i])
+		}
+		return less(v.results[i], v.results[j])
+	})
+
+	if i := slices.IndexFunc(v.results, func(r api.SearchResult) bool { return r.FileURL == selectedURL }); i >= 0 {
+		v.cursor = i
+	}
+}
+
+// setSort sorts by a column, reversing the order when it's already sorted by it
+func (v *SearchView) setSort(column searchColumn) {
+	if v.sortColumn == column {
+		v.sortDesc = !v.sortDesc
+	} else {
+		v.sortColumn = column
+		// Names and engines read best A-Z, numbers largest first
+		v.sortDesc = column != searchColumnName && column != searchColumnEngine
+	}
+	v.sortResults()
+}
+
+// handleOpenSearch switches to the search view and loads the search plugins
+func (m *MainView) handleOpenSearch() tea.Cmd {
+	m.viewMode = ViewModeSearch
+	m.search = newSearchView()
+	return m.fetchSearchPlugins()
+}
+
+// closeSearch returns to the torrent list, discarding the search on the server
+func (m *MainView) closeSearch() tea.Cmd {
+	id := m.search.jobID
+	m.viewMode = ViewModeMain
+	m.search = nil
+	if id == 0 {
+		return nil
+	}
+	return m.deleteSearch(id)
+}
+
+// deleteSearch discards a search job on the server. Failures are ignored:
+// the server drops finished jobs on its own.
+func (m *MainView) deleteSearch(id int) tea.Cmd {
+	return func() tea.Msg {
+		_ = m.apiClient.DeleteSearch(context.Background(), id)
+		return nil
+	}
+}
+
+// fetchSearchPlugins loads the installed search plugins
+func (m *MainView) fetchSearchPlugins() tea.Cmd {
+	return func() tea.Msg {
+		plugins, err := m.apiClient.GetSearchPlugins(context.Background())
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to load search plugins: %w", err))
+		}
+		return searchPluginsMsg(plugins)
+	}
+}
+
+// handleSearchPlugins shows freshly loaded search plugins
+func (m *MainView) handleSearchPlugins(plugins []api.SearchPlugin) {
+	if m.search == nil {
+		return
+	}
+	v := m.search
+	category := v.category()
+	v.plugins = plugins
+	v.pluginCursor = max(min(v.pluginCursor, len(plugins)-1), 0)
+
+	// Forget selected plugins that were uninstalled
+	v.selectedPlugins = slices.DeleteFunc(v.selectedPlugins, func(name string) bool {
+		return !slices.ContainsFunc(plugins, func(p api.SearchPlugin) bool { return p.Name == name })
+	})
+	v.categoryIndex = max(slices.IndexFunc(v.categories(), func(c api.SearchCategory) bool { return c.ID == category.ID }), 0)
+}
+
+// startSearch starts a search for the query, replacing the current one
+func (m *MainView) startSearch() tea.Cmd {
+	v := m.search
+	query := strings.TrimSpace(v.query)
+	if query == "" {
+		return nil
+	}
+	plugins := slices.Clone(v.selectedPlugins)
+	category := v.category().ID
+
+	var cmds []tea.Cmd
+	if v.jobID != 0 {
+		cmds = append(cmds, m.deleteSearch(v.jobID))
+	}
+	v.jobID = 0
+
+	cmds = append(cmds, func() tea.Msg {
+		id, err := m.apiClient.StartSearch(context.Background(), query, plugins, category)
+		if err != nil {
+			return errorMsg(fmt.Errorf("failed to start search: %w", err))
+		}
+		return searchStartedMsg(id)
+	})
+	return tea.Batch(cmds...)
+}
+
+// handleSearchStarted shows the new search and starts collecting its results
+func (m *MainView) handleSearchStarted(id int) tea.Cmd {
+	v := m.search
+	if v == nil {
+		// The view was closed while the search started
+		return m.deleteSearch(id)
+	}
+
+	var cmds []tea.Cmd
+	if v.jobID != 0 {
+		// Another search started in the meantime; the latest one wins
+		cmds = append(cmds, m.deleteSearch(v.jobID))
+	}
+	v.jobID = id
+	v.status = api.SearchStatusRunning
+	v.total = 0
+	v.results = nil
+	v.pollFailures = 0
+	v.cursor = 0
+	v.editingQuery = false
+	return tea.Batch(append(cmds, m.fetchSearchResults(id, 0))...)
+}
+
+// fetchSearchResults loads the results a search found after the first offset ones
+func (m *MainView) fetchSearchResults(id, offset int) tea.Cmd {
+	return func() tea.Msg {
+		results, err := m.apiClient.GetSearchResults(context.Background(), id, 0, offset)
+		if err != nil {
+			return searchResultsMsg{id: id, offset: offset, err: fmt.Errorf("failed to load search results: %w", err)}
+		}
+		return searchResultsMsg{id: id, offset: offset, results: results}
+	}
+}
+
+// handleSearchResults adds newly found results to the table and keeps
+// polling while the search runs, backing off while polls fail
+func (m *MainView) handleSearchResults(msg searchResultsMsg) tea.Cmd {
+	v := m.search
+	if v == nil || msg.id != v.jobID || msg.offset != len(v.results) {
+		return nil
+	}
+	if msg.err != nil {
+		v.pollFailures++
+		delay := min(searchPollInterval<<min(v.pollFailures, 5), searchMaxPollBackoff)
+		return tea.Batch(func() tea.Msg { return errorMsg(msg.err) }, searchPollCmd(msg.id, delay))
+	}
+	v.pollFailures = 0
+	v.results = append(v.results, msg.results.Results...)
+	v.status = msg.results.Status
+	v.total = msg.results.Total
+	v.sortResults()
+
+	if v.status != api.SearchStatusRunning && len(v.results) >= v.total {
+		return nil
+	}
+	return searchPollCmd(msg.id, searchPollInterval)
+}
+
+// searchPollCmd polls the search job for new results after delay
+func searchPollCmd(id int, delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return searchPollMsg(id)
+	})
+}
+
+// handleSearchPoll fetches new results if the search is still shown
+func (m *MainView) handleSearchPoll(id int) tea.Cmd {
+	if m.search == nil || m.search.jobID != id {
+		return nil
+	}
+	return m.fetchSearchResults(id, len(m.search.results))
+}
+
+// handleSearchKeys handles keyboard input in the search view
+func (m *MainView) handleSearchKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.search
+	switch {
+	case v.editingQuery:
+		return m.handleSearchQueryKeys(keyMsg)
+	case v.showPlugins:
+		return m.handleSearchPluginKeys(keyMsg)
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		return m.closeSearch()
+	case "/":
+		v.editingQuery = true
+	case "p":
+		v.showPlugins = true
+	case "up", "k":
+		if v.cursor > 0 {
+			v.cursor--
+		}
+	case "down", "j":
+		if v.cursor < len(v.results)-1 {
+			v.cursor++
+		}
+	case "g":
+		v.cursor = 0
+	case "G":
+		v.cursor = max(len(v.results)-1, 0)
+	case "1", "2", "3", "4", "5":
+		v.setSort(searchColumn(keyMsg.String()[0] - '1'))
+	case "x":
+		if v.jobID != 0 && v.status == api.SearchStatusRunning {
+			id := v.jobID
+			return func() tea.Msg {
+				if err := m.apiClient.StopSearch(context.Background(), id); err != nil {
+					return errorMsg(fmt.Errorf("failed to stop search: %w", err))
+				}
+				return successMsg("search stopped")
+			}
+		}
+	case "enter", "a":
+		if result := v.selectedResult(); result != nil {
+			return m.addTorrentURL(result.FileURL, api.AddTorrentOptions{})
+		}
+	}
+	return nil
+}
+
+// handleSearchQueryKeys handles typing the query and picking a category
+func (m *MainView) handleSearchQueryKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.search
+	switch keyMsg.String() {
+	case "esc":
+		if v.jobID == 0 {
+			return m.closeSearch()
+		}
+		v.editingQuery = false
+	case "enter":
+		return m.startSearch()
+	case "tab":
+		v.categoryIndex = (v.categoryIndex + 1) % len(v.categories())
+	case "shift+tab":
+		n := len(v.categories())
+		v.categoryIndex = (v.categoryIndex - 1 + n) % n
+	case "backspace":
+		v.query = deleteLastRune(v.query)
+	case "ctrl+u":
+		v.query = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			v.query = appendPrintable(v.query, keyMsg.Text)
+		}
+	}
+	return nil
+}
+
+// handleSearchPluginKeys handles the plugin list: picking the plugins to
+// search and enabling or disabling them on the server
+func (m *MainView) handleSearchPluginKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	v := m.search
+	switch keyMsg.String() {
+	case "esc", "p":
+		v.showPlugins = false
+		return nil
+	case "up", "k":
+		if v.pluginCursor > 0 {
+			v.pluginCursor--
+		}
+		return nil
+	case "down", "j":
+		if v.pluginCursor < len(v.plugins)-1 {
+			v.pluginCursor++
+		}
+		return nil
+	}
+
+	if v.pluginCursor >= len(v.plugins) {
+		return nil
+	}
+	plugin := v.plugins[v.pluginCursor]
+
+	switch keyMsg.String() {
+	case "enter":
+		if i := slices.Index(v.selectedPlugins, plugin.Name); i >= 0 {
+			v.selectedPlugins = slices.Delete(v.selectedPlugins, i, i+1)
+		} else {
+			v.selectedPlugins = append(v.selectedPlugins, plugin.Name)
+		}
+		v.categoryIndex = 0
+	case "space", " ":
+		enable := !plugin.Enabled
+		state := "disabled"
+		if enable {
+			state = "enabled"
+		}
+		return func() tea.Msg {
+			if err := m.apiClient.EnableSearchPlugins(context.Background(), []string{plugin.Name}, enable); err != nil {
+				return errorMsg(fmt.Errorf("failed to update search plugin: %w", err))
+			}
+			return searchPluginsUpdatedMsg(fmt.Sprintf("%s search plugin: %s", state, plugin.Name))
+		}
+	}
+	return nil
+}
+
+// renderSearchView renders the search view in place of the torrent list
+func (m *MainView) renderSearchView() string {
+	helpHeight := strings.Count(m.help.View(m.keys), "\n") + 1
+	contentHeight := m.height - helpHeight - 1
+	innerWidth := max(m.width-6, 40) // Panel borders and padding
+
+	content := styles.DimStyle.Render("Loading...")
+	if v := m.search; v != nil {
+		header := v.renderSearchHeader()
+		bodyHeight := max(contentHeight-2-strings.Count(header, "\n")-2, 1)
+		var body string
+		if v.showPlugins {
+			body = v.renderPluginList(innerWidth, bodyHeight)
+		} else {
+			body = v.renderResultsTable(innerWidth, bodyHeight)
+		}
+		content = lipgloss.JoinVertical(lipgloss.Left, header, "", body)
+	}
+	panel := styles.FocusedPanelStyle.Width(m.width).Height(contentHeight).Render(content)
+
+	// Status line at the bottom - priority: error (red) > success (green) > help
+	var statusView string
+	if m.lastError != nil {
+		statusView = styles.ErrorStyle.Render(fmt.Sprintf("Error: %v", m.lastError))
+	} else if m.lastSuccess != "" {
+		statusView = styles.SuccessStyle.Render(fmt.Sprintf("✓ %s", m.lastSuccess))
+	} else {
+		statusView = m.renderSearchHelp()
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, panel, statusView)
+}
+
+// renderSearchHelp renders the key hints for the current search view state
+func (m *MainView) renderSearchHelp() string {
+	v := m.search
+	switch {
+	case v == nil:
+		return ""
+	case v.editingQuery:
+		return styles.DimStyle.Render("Enter search • Tab/Shift+Tab category • Ctrl+U clear • Esc back")
+	case v.showPlugins:
+		return styles.DimStyle.Render("↑↓ select • Enter search with this plugin • Space enable/disable • Esc back to results")
+	}
+	return styles.DimStyle.Render("↑↓ select • Enter add torrent • 1-5 sort • / new search • x stop • p plugins • Esc back")
+}
+
+// renderSearchHeader renders the query, category, plugins and search progress
+func (v *SearchView) renderSearchHeader() string {
+	query := v.query
+	queryStyle := styles.TextStyle
+	if v.editingQuery {
+		query += "▊"
+		queryStyle = styles.AccentStyle
+	}
+
+	plugins := "all enabled"
+	if len(v.selectedPlugins) > 0 {
+		plugins = strings.Join(v.selectedPlugins, ", ")
+	}
+	enabled := 0
+	for _, plugin := range v.plugins {
+		if plugin.Enabled {
+			enabled++
+		}
+	}
+	if len(v.plugins) > 0 {
+		plugins += fmt.Sprintf(" (%d of %d installed enabled)", enabled, len(v.plugins))
+	}
+
+	var status string
+	switch {
+	case v.status == "":
+		status = styles.DimStyle.Render("Type what to search for and press Enter")
+	case v.status == api.SearchStatusRunning:
+		status = styles.WarningStyle.Render(fmt.Sprintf("Searching... %d result(s)", len(v.results)))
+	default:
+		status = styles.SuccessStyle.Render(fmt.Sprintf("Finished: %d result(s)", len(v.results)))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		styles.TitleStyle.Render("Search: ")+queryStyle.Render(query),
+		styles.DimStyle.Render("Category: ")+v.category().Name+styles.DimStyle.Render("   Plugins: ")+plugins,
+		status,
+	)
+}
+
+// renderResultsTable renders the sorted results, scrolled to the cursor
+func (v *SearchView) renderResultsTable(width, height int) string {
+	nameWidth := width
+	for _, column := range searchColumns[1:] {
+		nameWidth -= column.width + 1
+	}
+	nameWidth = max(nameWidth, 10)
+
+	cell := func(i int, text string) string {
+		w := searchColumns[i].width
+		if w == 0 {
+			w = nameWidth
+		}
+		if i == 0 || i == int(searchColumnEngine) {
+			return fmt.Sprintf("%-*s", w, styles.TruncateString(text, w))
+		}
+		return fmt.Sprintf("%*s", w, styles.TruncateString(text, w))
+	}
+
+	var headers []string
+	for i, column := range searchColumns {
+		title := fmt.Sprintf("%d %s", i+1, column.title)
+		if searchColumn(i) == v.sortColumn {
+			if v.sortDesc {
+				title += " ↓"
+			} else {
+				title += " ↑"
+			}
+		}
+		headers = append(headers, cell(i, title))
+	}
+	header := styles.SubtitleStyle.Render(strings.Join(headers, " "))
+
+	var rows []string
+	for i, result := range v.results {
+		engine := result.EngineName
+		if engine == "" {
+			engine = strings.TrimPrefix(strings.TrimPrefix(result.SiteURL, "https://"), "http://")
+		}
+		line := strings.Join([]string{
+			cell(0, result.FileName),
+			cell(1, styles.FormatBytes(result.FileSize)),
+			cell(2, fmt.Sprint(result.Seeders)),
+			cell(3, fmt.Sprint(result.Leechers)),
+			cell(4, engine),
+		}, " ")
+		rows = append(rows, listRow(line, i == v.cursor, !v.editingQuery))
+	}
+	if len(rows) == 0 && v.status != "" {
+		rows = append(rows, styles.DimStyle.Render("No results yet"))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, strings.Join(scrollWindow(rows, v.cursor, height-1), "\n"))
+}
+
+// renderPluginList renders the installed plugins with their state
+func (v *SearchView) renderPluginList(width, height int) string {
+	lines := []string{styles.SubtitleStyle.Render("Search plugins")}
+	if len(v.plugins) == 0 {
+		lines = append(lines, styles.DimStyle.Render("No search plugins installed - install them from qBittorrent's search tab"))
+	}
+	var rows []string
+	for i, plugin := range v.plugins {
+		state := "✗ disabled"
+		if plugin.Enabled {
+			state = "✓ enabled "
+		}
+		selected := "  "
+		if slices.Contains(v.selectedPlugins, plugin.Name) {
+			selected = "● "
+		}
+		name := plugin.FullName
+		if name == "" {
+			name = plugin.Name
+		}
+		line := fmt.Sprintf("%s%s  %s %s", selected, state, name, plugin.Version)
+		rows = append(rows, listRow(styles.TruncateString(line, width), i == v.pluginCursor, true))
+	}
+	lines = append(lines, scrollWindow(rows, v.pluginCursor, height-2)...)
+	lines = append(lines, styles.DimStyle.Render("● searched with when any are picked, otherwise all enabled plugins are used"))
+	return strings.Join(lines, "\n")
+}
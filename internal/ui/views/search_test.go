@@ -0,0 +1,163 @@
+package views
+
+import (
+	"context"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+// runCmd runs a command and feeds its messages to the view, returning the
+// commands that produces without running them (they may be timers)
+func runCmd(m *MainView, cmd tea.Cmd) []tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var next []tea.Cmd
+		for _, c := range batch {
+			next = append(next, runCmd(m, c)...)
+		}
+		return next
+	}
+	if msg == nil {
+		return nil
+	}
+	_, next := m.Update(msg)
+	return []tea.Cmd{next}
+}
+
+func newSearchTestView(t *testing.T) (*MainView, *api.MockClient) {
+	t.Helper()
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.SearchPlugins = []api.SearchPlugin{
+		{Name: "one", FullName: "Engine One", Enabled: true, SupportedCategories: []api.SearchCategory{{ID: "software", Name: "Software"}}},
+		{Name: "two", FullName: "Engine Two", SupportedCategories: []api.SearchCategory{{ID: "movies", Name: "Movies"}}},
+	}
+	mock.SearchResults = []api.SearchResult{
+		{FileName: "Ubuntu 24.04 Desktop", FileURL: "magnet:?xt=urn:btih:desktop", FileSize: 6 << 30, Seeders: 50, EngineName: "one"},
+		{FileName: "Ubuntu 24.04 Server", FileURL: "magnet:?xt=urn:btih:server", FileSize: 3 << 30, Seeders: 80, EngineName: "one"},
+		{FileName: "Ubuntu 22.04 Desktop", FileURL: "magnet:?xt=urn:btih:old", FileSize: 5 << 30, Seeders: 200, EngineName: "one"},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.width, m.height = 120, 30
+	runCmd(m, m.handleOpenSearch())
+	if m.viewMode != ViewModeSearch || len(m.search.plugins) != 2 {
+		t.Fatal("search view should open with the plugins loaded")
+	}
+	return m, mock
+}
+
+func TestSearchViewStreamsAndSortsResults(t *testing.T) {
+	m, mock := newSearchTestView(t)
+	v := m.search
+
+	m.Update(tea.PasteMsg{Content: "ubuntu\n"})
+	pressKeys(m, "tab")
+	if v.category().ID != "software" {
+		t.Fatalf("tab should pick the enabled plugin's category, got %+v", v.category())
+	}
+
+	// Start the search; the first poll finds two results
+	next := runCmd(m, pressKeys(m, "enter"))
+	runCmd(m, next[0])
+	if v.jobID == 0 || v.status != api.SearchStatusRunning || len(v.results) != 2 {
+		t.Fatalf("first results should stream in, got %d results (%s)", len(v.results), v.status)
+	}
+	if v.results[0].Seeders != 80 {
+		t.Errorf("results should be sorted by seeds, got %+v", v.results)
+	}
+	pressKeys(m, "down") // Desktop
+
+	// The next poll finds the rest and finishes
+	runCmd(m, runCmd(m, func() tea.Msg { return searchPollMsg(v.jobID) })[0])
+	if v.status != api.SearchStatusStopped || len(v.results) != 3 {
+		t.Fatalf("search should finish with all results, got %d (%s)", len(v.results), v.status)
+	}
+	if v.results[0].Seeders != 200 || v.selectedResult().FileURL != "magnet:?xt=urn:btih:desktop" {
+		t.Error("new results should be sorted in, keeping the cursor on the same result")
+	}
+
+	// Sort by size, largest first, then reversed
+	pressKeys(m, "2")
+	if v.results[0].FileSize != 6<<30 {
+		t.Errorf("results should be sorted by size, got %+v", v.results[0])
+	}
+	pressKeys(m, "2")
+	if v.sortDesc || v.results[0].FileSize != 3<<30 {
+		t.Error("sorting by the same column again should reverse the order")
+	}
+
+	// Add the selected result like a torrent URL
+	pressKeys(m, "g")
+	runCmd(m, pressKeys(m, "enter"))
+	if len(mock.AddedURLs) != 1 || mock.AddedURLs[0] != "magnet:?xt=urn:btih:server" {
+		t.Errorf("selected result should be added, got %v", mock.AddedURLs)
+	}
+
+	// Leaving the view discards the search on the server
+	runCmd(m, pressKeys(m, "esc"))
+	if m.viewMode != ViewModeMain || m.search != nil {
+		t.Fatal("esc should return to the torrent list")
+	}
+	if statuses, _ := mock.GetSearchStatus(context.Background(), 0); len(statuses) != 0 {
+		t.Errorf("search job should be deleted, got %v", statuses)
+	}
+}
+
+func TestSearchViewPlugins(t *testing.T) {
+	m, mock := newSearchTestView(t)
+	v := m.search
+
+	v.editingQuery = false
+
+	// Enable the second plugin on the server
+	pressKeys(m, "p", "down")
+	runCmd(m, runCmd(m, pressKeys(m, " "))[0])
+	if !mock.SearchPlugins[1].Enabled || !v.plugins[1].Enabled {
+		t.Fatal("space should enable the plugin and reload the list")
+	}
+
+	// Search with just that plugin, which limits the categories
+	pressKeys(m, "enter")
+	if len(v.selectedPlugins) != 1 || v.selectedPlugins[0] != "two" {
+		t.Fatalf("enter should pick the plugin to search with, got %v", v.selectedPlugins)
+	}
+	if categories := v.categories(); len(categories) != 2 || categories[1].ID != "movies" {
+		t.Errorf("categories should come from the picked plugin, got %v", categories)
+	}
+
+	pressKeys(m, "esc", "/")
+	m.Update(tea.PasteMsg{Content: "ubuntu"})
+	next := runCmd(m, pressKeys(m, "enter"))
+	runCmd(m, next[0])
+	if len(v.results) != 0 || v.status != api.SearchStatusStopped {
+		t.Errorf("only plugin two should be searched, got %v", v.results)
+	}
+}
+
+func TestSearchViewRetriesFailedPolls(t *testing.T) {
+	m, mock := newSearchTestView(t)
+	v := m.search
+
+	m.Update(tea.PasteMsg{Content: "ubuntu\n"})
+	next := runCmd(m, pressKeys(m, "enter"))
+
+	// A failed poll is retried rather than ending the search
+	mock.GetError = api.NewNetworkError("request failed", nil)
+	retry := runCmd(m, next[0])
+	if v.pollFailures != 1 || len(retry) != 1 || retry[0] == nil {
+		t.Fatalf("failed poll should be retried, got %d failures", v.pollFailures)
+	}
+
+	mock.GetError = nil
+	runCmd(m, runCmd(m, func() tea.Msg { return searchPollMsg(v.jobID) })[0])
+	if v.pollFailures != 0 || len(v.results) != 2 {
+		t.Errorf("retried poll should load results, got %d results, %d failures", len(v.results), v.pollFailures)
+	}
+}
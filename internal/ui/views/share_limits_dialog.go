@@ -0,0 +1,354 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// ShareLimitMode selects how a share limit is applied
+type ShareLimitMode int
+
+const (
+	ShareLimitModeGlobal ShareLimitMode = iota
+	ShareLimitModeUnlimited
+	ShareLimitModeCustom
+)
+
+// String returns the display name of the mode
+func (m ShareLimitMode) String() string {
+	switch m {
+	case ShareLimitModeUnlimited:
+		return "Unlimited"
+	case ShareLimitModeCustom:
+		return "Custom"
+	default:
+		return "Global"
+	}
+}
+
+// ShareLimitField is one editable limit in the share limits dialog
+type ShareLimitField struct {
+	label string
+	mode  ShareLimitMode
+	input string // Custom value, only used in ShareLimitModeCustom
+	mixed bool   // Torrents differ; each keeps its own limit until edited
+}
+
+// Indexes of the share limits dialog fields
+const (
+	ShareFieldRatio = iota
+	ShareFieldSeedingTime
+	ShareFieldInactiveTime
+)
+
+// ShareLimitsDialog represents the ratio and seeding time limits dialog state
+type ShareLimitsDialog struct {
+	fields       [3]ShareLimitField
+	initial      [3]ShareLimitField // Fields as opened, to send only changed limits
+	focusedField int
+	targetHashes []string
+	targetName   string
+	current      map[string]api.ShareLimits // Each target's limits when opened
+}
+
+// torrentShareLimits returns a torrent's current share limits
+func torrentShareLimits(torrent api.Torrent) api.ShareLimits {
+	limits := api.ShareLimits{
+		RatioLimit:               torrent.RatioLimit,
+		SeedingTimeLimit:         torrent.SeedingTimeLimit,
+		InactiveSeedingTimeLimit: torrent.InactiveSeedingTimeLimit,
+	}
+	// Servers older than 4.6 don't report the inactive limit at all (0)
+	if limits.InactiveSeedingTimeLimit == 0 {
+		limits.InactiveSeedingTimeLimit = api.ShareLimitGlobal
+	}
+	return limits
+}
+
+// shareLimitField builds a dialog field from a torrent's limit setting
+func shareLimitField(label string, limit float64, custom string) ShareLimitField {
+	switch {
+	case limit == api.ShareLimitGlobal:
+		return ShareLimitField{label: label, mode: ShareLimitModeGlobal}
+	case limit < 0:
+		return ShareLimitField{label: label, mode: ShareLimitModeUnlimited}
+	default:
+		return ShareLimitField{label: label, mode: ShareLimitModeCustom, input: custom}
+	}
+}
+
+// handleShareLimitsDialog opens the share limits dialog for the marked or
+// selected torrents, prefilled from the first one where they all agree and
+// marked mixed where they don't
+func (m *MainView) handleShareLimitsDialog() {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return
+	}
+
+	d := &ShareLimitsDialog{
+		targetHashes: hashes,
+		targetName:   m.describeTorrents(hashes),
+		current:      make(map[string]api.ShareLimits, len(hashes)),
+	}
+	for _, hash := range hashes {
+		d.current[hash] = torrentShareLimits(m.torrentMap[hash])
+	}
+
+	first := d.current[hashes[0]]
+	sameRatio, sameTime, sameInactive := true, true, true
+	for _, hash := range hashes[1:] {
+		limits := d.current[hash]
+		sameRatio = sameRatio && limits.RatioLimit == first.RatioLimit
+		sameTime = sameTime && limits.SeedingTimeLimit == first.SeedingTimeLimit
+		sameInactive = sameInactive && limits.InactiveSeedingTimeLimit == first.InactiveSeedingTimeLimit
+	}
+
+	// Times are prefilled as exact minutes so they survive being parsed back
+	field := func(label string, same bool, limit float64, custom string) ShareLimitField {
+		if !same {
+			return ShareLimitField{label: label, mixed: true}
+		}
+		return shareLimitField(label, limit, custom)
+	}
+	d.fields = [3]ShareLimitField{
+		field("Ratio limit", sameRatio,
+			first.RatioLimit, strconv.FormatFloat(first.RatioLimit, 'f', -1, 64)),
+		field("Seeding time limit", sameTime,
+			float64(first.SeedingTimeLimit), strconv.FormatInt(first.SeedingTimeLimit, 10)),
+		field("Inactive seeding time limit", sameInactive,
+			float64(first.InactiveSeedingTimeLimit), strconv.FormatInt(first.InactiveSeedingTimeLimit, 10)),
+	}
+	d.initial = d.fields
+
+	m.shareLimitsDialog = d
+	m.showShareLimitsDialog = true
+}
+
+// closeShareLimitsDialog closes the share limits dialog and clears its state
+func (m *MainView) closeShareLimitsDialog() {
+	m.showShareLimitsDialog = false
+	m.shareLimitsDialog = nil
+}
+
+// appendInput adds typed or pasted text to the focused field, switching it
+// to a custom limit
+func (d *ShareLimitsDialog) appendInput(text string) {
+	field := &d.fields[d.focusedField]
+	field.mode = ShareLimitModeCustom
+	field.mixed = false
+	field.input = appendPrintable(field.input, text)
+}
+
+// changed reports which fields differ from how the dialog was opened
+func (d *ShareLimitsDialog) changed() [3]bool {
+	var changed [3]bool
+	for i, field := range d.fields {
+		initial := d.initial[i]
+		changed[i] = !field.mixed && (initial.mixed || field.mode != initial.mode ||
+			field.mode == ShareLimitModeCustom && strings.TrimSpace(field.input) != strings.TrimSpace(initial.input))
+	}
+	return changed
+}
+
+// handleShareLimitsDialogKeys handles keyboard input for the share limits dialog
+func (m *MainView) handleShareLimitsDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.shareLimitsDialog
+	field := &d.fields[d.focusedField]
+
+	switch keyMsg.String() {
+	case "esc":
+		m.closeShareLimitsDialog()
+	case "tab", "down":
+		d.focusedField = (d.focusedField + 1) % len(d.fields)
+	case "shift+tab", "up":
+		d.focusedField = (d.focusedField + len(d.fields) - 1) % len(d.fields)
+	case "right", "left":
+		// A mixed field starts choosing from Global
+		if field.mixed {
+			field.mixed = false
+		} else if keyMsg.String() == "right" {
+			field.mode = (field.mode + 1) % 3
+		} else {
+			field.mode = (field.mode + 2) % 3
+		}
+	case "enter":
+		return m.applyShareLimits()
+	case "backspace":
+		if field.mode == ShareLimitModeCustom {
+			field.input = deleteLastRune(field.input)
+		}
+	case "ctrl+u":
+		field.input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			d.appendInput(keyMsg.Text)
+		}
+	}
+
+	return nil
+}
+
+// shareLimits converts the dialog fields into API share limits
+func (d *ShareLimitsDialog) shareLimits() (api.ShareLimits, error) {
+	return parseShareLimits(d.fields)
+}
+
+// parseShareLimits converts ratio, seeding time and inactive seeding time
+// fields into API share limits
+func parseShareLimits(fields [3]ShareLimitField) (api.ShareLimits, error) {
+	limits := api.ShareLimits{
+		RatioLimit:               api.ShareLimitGlobal,
+		SeedingTimeLimit:         api.ShareLimitGlobal,
+		InactiveSeedingTimeLimit: api.ShareLimitGlobal,
+	}
+
+	ratio := fields[ShareFieldRatio]
+	switch ratio.mode {
+	case ShareLimitModeUnlimited:
+		limits.RatioLimit = api.ShareLimitUnlimited
+	case ShareLimitModeCustom:
+		value, err := strconv.ParseFloat(strings.TrimSpace(ratio.input), 64)
+		if err != nil || value < 0 {
+			return limits, fmt.Errorf("invalid ratio limit %q (examples: 1, 2.5)", ratio.input)
+		}
+		limits.RatioLimit = value
+	}
+
+	for _, entry := range []struct {
+		field ShareLimitField
+		limit *int64
+	}{
+		{fields[ShareFieldSeedingTime], &limits.SeedingTimeLimit},
+		{fields[ShareFieldInactiveTime], &limits.InactiveSeedingTimeLimit},
+	} {
+		switch entry.field.mode {
+		case ShareLimitModeUnlimited:
+			*entry.limit = api.ShareLimitUnlimited
+		case ShareLimitModeCustom:
+			minutes, err := styles.ParseSeedingTime(entry.field.input)
+			if err != nil {
+				return limits, err
+			}
+			*entry.limit = minutes
+		}
+	}
+
+	return limits, nil
+}
+
+// applyShareLimits validates the dialog and sends the changed limits to the
+// server. The API sets all three limits at once, so unchanged limits are
+// sent as each torrent's current value, grouping torrents that end up alike.
+func (m *MainView) applyShareLimits() tea.Cmd {
+	d := m.shareLimitsDialog
+
+	limits, err := d.shareLimits()
+	if err != nil {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+
+	changed := d.changed()
+	var order []api.ShareLimits
+	groups := make(map[api.ShareLimits][]string)
+	for _, hash := range d.targetHashes {
+		torrentLimits := d.current[hash]
+		if changed[ShareFieldRatio] {
+			torrentLimits.RatioLimit = limits.RatioLimit
+		}
+		if changed[ShareFieldSeedingTime] {
+			torrentLimits.SeedingTimeLimit = limits.SeedingTimeLimit
+		}
+		if changed[ShareFieldInactiveTime] {
+			torrentLimits.InactiveSeedingTimeLimit = limits.InactiveSeedingTimeLimit
+		}
+		if _, ok := groups[torrentLimits]; !ok {
+			order = append(order, torrentLimits)
+		}
+		groups[torrentLimits] = append(groups[torrentLimits], hash)
+	}
+
+	target := d.targetName
+	m.closeShareLimitsDialog()
+	if changed == [3]bool{} {
+		return nil
+	}
+
+	return func() tea.Msg {
+		for _, limits := range order {
+			if err := m.apiClient.SetShareLimits(context.Background(), groups[limits], limits); err != nil {
+				return errorMsg(fmt.Errorf("failed to set share limits: %w", err))
+			}
+		}
+		return successMsg(fmt.Sprintf("share limits updated: %s", target))
+	}
+}
+
+// renderShareLimitsDialog renders the share limits dialog
+func (m *MainView) renderShareLimitsDialog() string {
+	d := m.shareLimitsDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	title := styles.AccentStyle.Render("Share Limits")
+	target := styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(d.targetName, 50)))
+
+	renderField := func(field ShareLimitField, focused bool) string {
+		var modes []string
+		for _, mode := range []ShareLimitMode{ShareLimitModeGlobal, ShareLimitModeUnlimited, ShareLimitModeCustom} {
+			if mode == field.mode {
+				modes = append(modes, styles.AccentStyle.Render("["+mode.String()+"]"))
+			} else {
+				modes = append(modes, styles.DimStyle.Render(" "+mode.String()+" "))
+			}
+		}
+
+		inputStyle := styles.InputStyle.Width(60)
+		if focused {
+			inputStyle = styles.FocusedInputStyle.Width(60)
+		}
+		display := strings.Join(modes, " ")
+		if field.mixed {
+			display = styles.DimStyle.Render("(mixed) each torrent keeps its own limit")
+		} else if field.mode == ShareLimitModeCustom {
+			value := field.input
+			if focused {
+				value += "▊"
+			}
+			display += "  " + styles.TextStyle.Render(value)
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, field.label+":", inputStyle.Render(display))
+	}
+
+	var fields []string
+	for i, field := range d.fields {
+		fields = append(fields, renderField(field, i == d.focusedField))
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		title,
+		target,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, fields...),
+		styles.DimStyle.Render("Times e.g. 90 (minutes), 12h, 7d - inactive limit needs qBittorrent 4.6+"),
+		"",
+		styles.DimStyle.Render("Tab: Switch field  ←/→: Mode  Enter: Apply  Esc: Cancel"),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
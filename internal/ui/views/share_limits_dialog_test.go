@@ -0,0 +1,133 @@
+package views
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestShareLimitsDialogApplies(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{
+		{Hash: "hash1", Name: "one", RatioLimit: 2, SeedingTimeLimit: api.ShareLimitGlobal, InactiveSeedingTimeLimit: api.ShareLimitGlobal},
+		{Hash: "hash2", Name: "two", RatioLimit: 2, SeedingTimeLimit: api.ShareLimitUnlimited, InactiveSeedingTimeLimit: api.ShareLimitGlobal},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.torrentMap["hash1"] = mock.Torrents[0]
+	m.torrentMap["hash2"] = mock.Torrents[1]
+	m.torrentList.SetTorrents(mock.Torrents)
+	m.torrentList.MarkAll()
+	m.handleShareLimitsDialog()
+
+	d := m.shareLimitsDialog
+	if d.fields[ShareFieldRatio].mode != ShareLimitModeCustom || d.fields[ShareFieldRatio].input != "2" {
+		t.Errorf("shared ratio limit should be prefilled, got %+v", d.fields[ShareFieldRatio])
+	}
+	if !d.fields[ShareFieldSeedingTime].mixed {
+		t.Errorf("differing seeding time limits should be mixed, got %+v", d.fields[ShareFieldSeedingTime])
+	}
+
+	// Typing into a field switches it to a custom limit
+	d.focusedField = ShareFieldSeedingTime
+	m.Update(tea.PasteMsg{Content: "12h"})
+	m.handleShareLimitsDialogKeys(tea.KeyPressMsg{Code: tea.KeyTab})
+	m.handleShareLimitsDialogKeys(tea.KeyPressMsg{Code: tea.KeyRight})
+
+	cmd := m.applyShareLimits()
+	if msg, ok := cmd().(successMsg); !ok {
+		t.Fatalf("expected success, got %#v", msg)
+	}
+	for _, torrent := range mock.Torrents {
+		if torrent.RatioLimit != 2 || torrent.SeedingTimeLimit != 720 || torrent.InactiveSeedingTimeLimit != api.ShareLimitUnlimited {
+			t.Errorf("%s: got ratio %v, time %d, inactive %d", torrent.Hash, torrent.RatioLimit, torrent.SeedingTimeLimit, torrent.InactiveSeedingTimeLimit)
+		}
+	}
+	if m.showShareLimitsDialog {
+		t.Error("dialog should close after applying")
+	}
+}
+
+func TestShareLimitsDialogKeepsMixedLimits(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{
+		{Hash: "hash1", Name: "one", RatioLimit: 1.5, SeedingTimeLimit: 60, InactiveSeedingTimeLimit: api.ShareLimitGlobal},
+		{Hash: "hash2", Name: "two", RatioLimit: 3, SeedingTimeLimit: api.ShareLimitUnlimited, InactiveSeedingTimeLimit: api.ShareLimitGlobal},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.torrentMap["hash1"] = mock.Torrents[0]
+	m.torrentMap["hash2"] = mock.Torrents[1]
+	m.torrentList.SetTorrents(mock.Torrents)
+	m.torrentList.MarkAll()
+	m.handleShareLimitsDialog()
+
+	// Only the inactive limit is edited, the mixed ones stay per torrent
+	m.shareLimitsDialog.focusedField = ShareFieldInactiveTime
+	m.Update(tea.PasteMsg{Content: "30"})
+
+	cmd := m.applyShareLimits()
+	if msg, ok := cmd().(successMsg); !ok {
+		t.Fatalf("expected success, got %#v", msg)
+	}
+	want := map[string][2]float64{"hash1": {1.5, 60}, "hash2": {3, api.ShareLimitUnlimited}}
+	for _, torrent := range mock.Torrents {
+		w := want[torrent.Hash]
+		if torrent.RatioLimit != w[0] || float64(torrent.SeedingTimeLimit) != w[1] || torrent.InactiveSeedingTimeLimit != 30 {
+			t.Errorf("%s: got ratio %v, time %d, inactive %d", torrent.Hash, torrent.RatioLimit, torrent.SeedingTimeLimit, torrent.InactiveSeedingTimeLimit)
+		}
+	}
+}
+
+func TestShareLimitsDialogKeepsExactMinutes(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{
+		{Hash: "hash1", Name: "one", RatioLimit: api.ShareLimitGlobal, SeedingTimeLimit: 2190, InactiveSeedingTimeLimit: api.ShareLimitGlobal},
+	}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.torrentMap["hash1"] = mock.Torrents[0]
+	m.torrentList.SetTorrents(mock.Torrents)
+
+	// Applying without edits sends nothing
+	m.handleShareLimitsDialog()
+	if field := m.shareLimitsDialog.fields[ShareFieldSeedingTime]; field.input != "2190" {
+		t.Errorf("seeding time should be prefilled in minutes, got %q", field.input)
+	}
+	if cmd := m.applyShareLimits(); cmd != nil {
+		t.Error("applying without edits should not update the server")
+	}
+
+	// Editing another limit keeps the seeding time as it was
+	m.handleShareLimitsDialog()
+	m.handleShareLimitsDialogKeys(tea.KeyPressMsg{Code: tea.KeyRight})
+	cmd := m.applyShareLimits()
+	if msg, ok := cmd().(successMsg); !ok {
+		t.Fatalf("expected success, got %#v", msg)
+	}
+	if torrent := mock.Torrents[0]; torrent.SeedingTimeLimit != 2190 || torrent.RatioLimit != api.ShareLimitUnlimited {
+		t.Errorf("got ratio %v, time %d", torrent.RatioLimit, torrent.SeedingTimeLimit)
+	}
+}
+
+func TestShareLimitsDialogRejectsInvalidInput(t *testing.T) {
+	m := newTestMainView()
+	m.shareLimitsDialog = &ShareLimitsDialog{targetHashes: []string{"hash1"}}
+	m.shareLimitsDialog.fields[ShareFieldRatio] = ShareLimitField{mode: ShareLimitModeCustom, input: "lots"}
+	m.showShareLimitsDialog = true
+
+	cmd := m.applyShareLimits()
+	if _, ok := cmd().(errorMsg); !ok {
+		t.Error("invalid ratio should produce an error")
+	}
+	if !m.showShareLimitsDialog {
+		t.Error("dialog should stay open on invalid input")
+	}
+}
@@ -0,0 +1,389 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/filter"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// TagDialogMode represents the current screen of the tag dialog
+type TagDialogMode int
+
+const (
+	TagModeList TagDialogMode = iota
+	TagModeCreate
+	TagModeConfirmDelete
+)
+
+// TagState is the checkbox state of a tag across the target torrents
+type TagState int
+
+const (
+	TagUnchecked TagState = iota
+	TagPartial            // Only some of the target torrents have the tag
+	TagChecked
+)
+
+// TagDialog represents the tag picker dialog state
+type TagDialog struct {
+	mode         TagDialogMode
+	selectedIdx  int
+	targetHashes []string // Torrents the tag changes are applied to
+	targetName   string   // Torrent name, or "N torrents" for bulk changes
+
+	tags        []string            // All tags shown, sorted
+	initial     map[string]TagState // State when the dialog was opened
+	current     map[string]TagState // State after the user's toggles
+	newTagInput string
+}
+
+// NewTagDialog creates a tag dialog; torrentTags holds the tag string of
+// each target torrent and determines the initial checkbox states
+func NewTagDialog(targetHashes []string, targetName string, allTags []string, torrentTags []string) *TagDialog {
+	counts := make(map[string]int)
+	for _, tags := range torrentTags {
+		for _, tag := range filter.SplitTags(tags) {
+			counts[tag]++
+		}
+	}
+
+	d := &TagDialog{
+		mode:         TagModeList,
+		targetHashes: targetHashes,
+		targetName:   targetName,
+		initial:      make(map[string]TagState),
+		current:      make(map[string]TagState),
+	}
+
+	// Include tags that are on torrents but missing from the global list
+	seen := make(map[string]struct{})
+	for _, tag := range allTags {
+		seen[tag] = struct{}{}
+		d.tags = append(d.tags, tag)
+	}
+	for tag := range counts {
+		if _, ok := seen[tag]; !ok {
+			d.tags = append(d.tags, tag)
+		}
+	}
+	sort.Strings(d.tags)
+
+	for _, tag := range d.tags {
+		state := TagUnchecked
+		switch count := counts[tag]; {
+		case count == 0:
+		case count == len(torrentTags):
+			state = TagChecked
+		default:
+			state = TagPartial
+		}
+		d.initial[tag] = state
+		d.current[tag] = state
+	}
+
+	return d
+}
+
+// toggle cycles the checkbox of the selected tag. Partial tags become
+// checked first so they can be applied to all targets.
+func (d *TagDialog) toggle() {
+	if len(d.tags) == 0 {
+		return
+	}
+	tag := d.tags[d.selectedIdx]
+	if d.current[tag] == TagChecked {
+		d.current[tag] = TagUnchecked
+	} else {
+		d.current[tag] = TagChecked
+	}
+}
+
+// addTag adds a new tag to the list, checks it and selects it
+func (d *TagDialog) addTag(tag string) {
+	if _, exists := d.current[tag]; !exists {
+		d.tags = append(d.tags, tag)
+		sort.Strings(d.tags)
+		d.initial[tag] = TagUnchecked
+	}
+	d.current[tag] = TagChecked
+	for i, t := range d.tags {
+		if t == tag {
+			d.selectedIdx = i
+			break
+		}
+	}
+}
+
+// removeTag drops a deleted tag from the list, keeping the other toggles
+func (d *TagDialog) removeTag(tag string) {
+	for i, t := range d.tags {
+		if t == tag {
+			d.tags = append(d.tags[:i], d.tags[i+1:]...)
+			break
+		}
+	}
+	delete(d.initial, tag)
+	delete(d.current, tag)
+	d.selectedIdx = max(min(d.selectedIdx, len(d.tags)-1), 0)
+}
+
+// changes returns the tags to add to and remove from the target torrents
+func (d *TagDialog) changes() (add, remove []string) {
+	for _, tag := range d.tags {
+		before, after := d.initial[tag], d.current[tag]
+		if before == after {
+			continue
+		}
+		switch after {
+		case TagChecked:
+			add = append(add, tag)
+		case TagUnchecked:
+			remove = append(remove, tag)
+		}
+	}
+	return add, remove
+}
+
+// handleTagDialog opens the tag dialog for the marked or selected torrents
+func (m *MainView) handleTagDialog() {
+	hashes := m.getActionTorrentHashes()
+	torrentTags := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		torrentTags = append(torrentTags, m.torrentMap[hash].Tags)
+	}
+
+	m.tagDialog = NewTagDialog(hashes, m.describeTorrents(hashes), m.tags, torrentTags)
+	m.showTagDialog = true
+}
+
+// closeTagDialog closes the tag dialog and clears its state
+func (m *MainView) closeTagDialog() {
+	m.showTagDialog = false
+	m.tagDialog = nil
+}
+
+// handleTagDialogKeys handles keyboard input for the tag dialog
+func (m *MainView) handleTagDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.tagDialog
+
+	switch d.mode {
+	case TagModeList:
+		switch keyMsg.String() {
+		case "esc":
+			m.closeTagDialog()
+		case "up", "k":
+			if d.selectedIdx > 0 {
+				d.selectedIdx--
+			}
+		case "down", "j":
+			if d.selectedIdx < len(d.tags)-1 {
+				d.selectedIdx++
+			}
+		case "space", " ", "x":
+			d.toggle()
+		case "enter":
+			return m.applyTagChanges()
+		case "n":
+			d.mode = TagModeCreate
+			d.newTagInput = ""
+		case "d":
+			if len(d.tags) > 0 {
+				d.mode = TagModeConfirmDelete
+			}
+		}
+
+	case TagModeCreate:
+		switch keyMsg.String() {
+		case "esc":
+			d.mode = TagModeList
+		case "enter":
+			tag := strings.TrimSpace(d.newTagInput)
+			if tag == "" {
+				return nil
+			}
+			if strings.Contains(tag, ",") {
+				return func() tea.Msg {
+					return errorMsg(fmt.Errorf("tag names cannot contain commas"))
+				}
+			}
+			d.addTag(tag)
+			d.mode = TagModeList
+		case "backspace":
+			d.newTagInput = deleteLastRune(d.newTagInput)
+		case "ctrl+u":
+			d.newTagInput = ""
+		default:
+			if len(keyMsg.Text) > 0 {
+				d.newTagInput = appendPrintable(d.newTagInput, keyMsg.Text)
+			}
+		}
+
+	case TagModeConfirmDelete:
+		switch keyMsg.String() {
+		case "y", "Y", "enter":
+			// Stay open so the pending toggles can still be applied
+			tag := d.tags[d.selectedIdx]
+			d.removeTag(tag)
+			d.mode = TagModeList
+			return m.deleteTag(tag)
+		case "n", "N", "esc":
+			d.mode = TagModeList
+		}
+	}
+
+	return nil
+}
+
+// applyTagChanges adds and removes tags on the dialog's target torrents.
+// Tags created in the dialog are registered globally even without targets.
+func (m *MainView) applyTagChanges() tea.Cmd {
+	d := m.tagDialog
+	add, remove := d.changes()
+	hashes := d.targetHashes
+	target := d.targetName
+	m.closeTagDialog()
+
+	if len(hashes) == 0 {
+		remove = nil
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		if len(hashes) == 0 {
+			if err := m.apiClient.CreateTags(ctx, add); err != nil {
+				return errorMsg(fmt.Errorf("failed to create tags: %w", err))
+			}
+			return successMsg(fmt.Sprintf("created tags: %s", strings.Join(add, ", ")))
+		}
+		if len(add) > 0 {
+			if err := m.apiClient.AddTags(ctx, hashes, add); err != nil {
+				return errorMsg(fmt.Errorf("failed to add tags: %w", err))
+			}
+		}
+		if len(remove) > 0 {
+			if err := m.apiClient.RemoveTags(ctx, hashes, remove); err != nil {
+				return errorMsg(fmt.Errorf("failed to remove tags: %w", err))
+			}
+		}
+		return successMsg(fmt.Sprintf("updated tags: %s", target))
+	}
+}
+
+// deleteTag deletes a tag from the server and all torrents
+func (m *MainView) deleteTag(tag string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := m.apiClient.DeleteTags(ctx, []string{tag}); err != nil {
+			return errorMsg(fmt.Errorf("failed to delete tag: %w", err))
+		}
+		return successMsg(fmt.Sprintf("deleted tag: %s", tag))
+	}
+}
+
+// renderTagDialog renders the tag dialog
+func (m *MainView) renderTagDialog() string {
+	d := m.tagDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	title := styles.AccentStyle.Render("Tags")
+	target := styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(d.targetName, 50)))
+	if len(d.targetHashes) == 0 {
+		target = styles.DimStyle.Render("No torrent selected - new tags are created only")
+	}
+
+	var content, instructions string
+	switch d.mode {
+	case TagModeCreate:
+		inputStyle := styles.FocusedInputStyle.Width(60)
+		content = lipgloss.JoinVertical(lipgloss.Left,
+			styles.SubtitleStyle.Render("New Tag"),
+			"",
+			inputStyle.Render(styles.TextStyle.Render(d.newTagInput+"▊")),
+		)
+		instructions = "Enter: Add  Esc: Back"
+	case TagModeConfirmDelete:
+		content = lipgloss.JoinVertical(lipgloss.Center,
+			"",
+			fmt.Sprintf("Delete tag %s?", styles.ErrorStyle.Render(d.tags[d.selectedIdx])),
+			styles.DimStyle.Render("The tag will be removed from all torrents"),
+			"",
+		)
+		instructions = "Y/Enter: Confirm  N/Esc: Cancel"
+	default:
+		content = m.renderTagList()
+		instructions = "Space: Toggle  Enter: Apply  n: New  d: Delete  Esc: Cancel"
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		title,
+		target,
+		"",
+		content,
+		"",
+		styles.DimStyle.Render(instructions),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
+
+// renderTagList renders the tag checkbox list
+func (m *MainView) renderTagList() string {
+	d := m.tagDialog
+
+	var lines []string
+	maxLines := 12 // Limit displayed tags
+
+	// Calculate visible range (simple scrolling)
+	startIdx := 0
+	if d.selectedIdx >= maxLines {
+		startIdx = d.selectedIdx - maxLines + 1
+	}
+
+	for i := startIdx; i < len(d.tags) && i < startIdx+maxLines; i++ {
+		tag := d.tags[i]
+		var box string
+		switch d.current[tag] {
+		case TagChecked:
+			box = "[x]"
+		case TagPartial:
+			box = "[~]"
+		default:
+			box = "[ ]"
+		}
+
+		line := fmt.Sprintf("%s %-54s", box, styles.TruncateString(tag, 54))
+		if d.current[tag] != d.initial[tag] {
+			line = styles.AccentStyle.Render(line)
+		}
+		if i == d.selectedIdx {
+			line = styles.SelectedRowStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(d.tags) == 0 {
+		lines = append(lines, styles.DimStyle.Render("No tags yet - press n to create one"))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		strings.Repeat("─", 60),
+		strings.Join(lines, "\n"),
+		strings.Repeat("─", 60),
+	)
+}
@@ -0,0 +1,80 @@
+package views
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+)
+
+func TestNewTagDialogStates(t *testing.T) {
+	d := NewTagDialog([]string{"a", "b"}, "2 torrents", []string{"hd", "4k", "unused"}, []string{"hd, 4k", "hd, orphan"})
+
+	want := map[string]TagState{
+		"4k":     TagPartial,
+		"hd":     TagChecked,
+		"orphan": TagPartial,
+		"unused": TagUnchecked,
+	}
+	if !reflect.DeepEqual(d.current, want) {
+		t.Errorf("initial states: got %v, want %v", d.current, want)
+	}
+	if want := []string{"4k", "hd", "orphan", "unused"}; !reflect.DeepEqual(d.tags, want) {
+		t.Errorf("tags: got %v, want %v", d.tags, want)
+	}
+}
+
+func TestTagDialogChanges(t *testing.T) {
+	d := NewTagDialog([]string{"a", "b"}, "2 torrents", []string{"hd", "4k", "unused"}, []string{"hd, 4k", "hd"})
+
+	// 4k: partial -> checked, hd: checked -> unchecked, unused: untouched
+	d.selectedIdx = 0
+	d.toggle()
+	d.selectedIdx = 1
+	d.toggle()
+	d.addTag("new")
+
+	add, remove := d.changes()
+	if want := []string{"4k", "new"}; !reflect.DeepEqual(add, want) {
+		t.Errorf("add: got %v, want %v", add, want)
+	}
+	if want := []string{"hd"}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("remove: got %v, want %v", remove, want)
+	}
+	if d.tags[d.selectedIdx] != "new" {
+		t.Errorf("new tag should be selected, got %q", d.tags[d.selectedIdx])
+	}
+}
+
+func TestTagDialogDeleteKeepsToggles(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Tags = []string{"4k", "hd", "old"}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.tagDialog = NewTagDialog([]string{"a"}, "one", mock.Tags, []string{""})
+	m.showTagDialog = true
+
+	// Check 4k, then delete old
+	pressKeys(m, "space", "down", "down", "d")
+	cmd := pressKeys(m, "y")
+	if cmd == nil {
+		t.Fatal("expected a command to delete the tag")
+	}
+	if _, ok := cmd().(successMsg); !ok || slices.Contains(mock.Tags, "old") {
+		t.Fatalf("tag should be deleted, got %v", mock.Tags)
+	}
+
+	d := m.tagDialog
+	if !m.showTagDialog || d.mode != TagModeList {
+		t.Fatal("dialog should stay open after deleting a tag")
+	}
+	if want := []string{"4k", "hd"}; !reflect.DeepEqual(d.tags, want) || d.tags[d.selectedIdx] != "hd" {
+		t.Errorf("deleted tag should be gone, got %v selected %d", d.tags, d.selectedIdx)
+	}
+	if add, _ := d.changes(); !reflect.DeepEqual(add, []string{"4k"}) {
+		t.Errorf("pending toggles should be kept, got add %v", add)
+	}
+}
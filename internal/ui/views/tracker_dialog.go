@@ -0,0 +1,350 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/components"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/styles"
+)
+
+// TrackerDialogMode identifies what the tracker dialog does
+type TrackerDialogMode int
+
+const (
+	TrackerModeAdd     TrackerDialogMode = iota // Add trackers to one torrent
+	TrackerModeEdit                             // Edit one tracker URL of one torrent
+	TrackerModeReplace                          // Replace part of the URL across torrents
+)
+
+// TrackerDialog represents the tracker editor state
+type TrackerDialog struct {
+	mode       TrackerDialogMode
+	hashes     []string // The edited torrent, or every torrent for a replace
+	targetName string
+	oldURL     string // Tracker being edited
+
+	input        string // URLs to add, new URL, or text to find
+	replaceInput string // Replacement text
+	focusReplace bool
+}
+
+// handleTrackerRequest opens the tracker dialog from the Trackers tab
+func (m *MainView) handleTrackerRequest(msg components.TrackerRequestMsg) {
+	d := &TrackerDialog{
+		mode:       TrackerModeAdd,
+		hashes:     []string{msg.Hash},
+		targetName: m.describeTorrents([]string{msg.Hash}),
+	}
+	if msg.URL != "" {
+		d.mode = TrackerModeEdit
+		d.oldURL = msg.URL
+		d.input = msg.URL
+	}
+	m.trackerDialog = d
+	m.showTrackerDialog = true
+}
+
+// handleReplaceTrackers opens the tracker URL replace dialog for the marked
+// or selected torrents
+func (m *MainView) handleReplaceTrackers() {
+	hashes := m.getActionTorrentHashes()
+	if len(hashes) == 0 {
+		return
+	}
+	m.trackerDialog = &TrackerDialog{
+		mode:       TrackerModeReplace,
+		hashes:     hashes,
+		targetName: m.describeTorrents(hashes),
+	}
+	m.showTrackerDialog = true
+}
+
+// closeTrackerDialog closes the tracker dialog and clears its state
+func (m *MainView) closeTrackerDialog() {
+	m.showTrackerDialog = false
+	m.trackerDialog = nil
+}
+
+// handleRemoveTrackerRequest asks for confirmation before removing a tracker
+func (m *MainView) handleRemoveTrackerRequest(msg components.TrackerRemoveRequestMsg) {
+	m.removeTrackerTarget = msg
+	m.showRemoveTrackerDialog = true
+}
+
+// confirmRemoveTracker removes the tracker after user confirmation
+func (m *MainView) confirmRemoveTracker() tea.Cmd {
+	target := m.removeTrackerTarget
+	m.cancelRemoveTracker()
+
+	return func() tea.Msg {
+		if err := m.apiClient.RemoveTrackers(context.Background(), target.Hash, []string{target.URL}); err != nil {
+			return components.DetailsActionMsg{Err: fmt.Errorf("failed to remove tracker: %w", err)}
+		}
+		return components.DetailsActionMsg{Success: fmt.Sprintf("removed tracker: %s", styles.TruncateString(target.URL, 50))}
+	}
+}
+
+// cancelRemoveTracker closes the remove tracker confirmation dialog
+func (m *MainView) cancelRemoveTracker() {
+	m.showRemoveTrackerDialog = false
+	m.removeTrackerTarget = components.TrackerRemoveRequestMsg{}
+}
+
+// focusedTrackerInput returns the input string of the focused field
+func (d *TrackerDialog) focusedTrackerInput() *string {
+	if d.focusReplace {
+		return &d.replaceInput
+	}
+	return &d.input
+}
+
+// validateTrackerURL checks that a tracker announce URL is absolute
+func validateTrackerURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid tracker URL: %s", raw)
+	}
+	return nil
+}
+
+// trackerURLs splits the add input into URLs; several can be separated by
+// spaces, commas or newlines
+func trackerURLs(input string) []string {
+	return strings.FieldsFunc(input, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\n' || r == '\t'
+	})
+}
+
+// handleTrackerDialogKeys handles keyboard input for the tracker dialog
+func (m *MainView) handleTrackerDialogKeys(keyMsg tea.KeyPressMsg) tea.Cmd {
+	d := m.trackerDialog
+	input := d.focusedTrackerInput()
+
+	switch keyMsg.String() {
+	case "esc":
+		m.closeTrackerDialog()
+	case "tab", "shift+tab", "up", "down":
+		if d.mode == TrackerModeReplace {
+			d.focusReplace = !d.focusReplace
+		}
+	case "enter":
+		return m.applyTrackerDialog()
+	case "backspace":
+		*input = deleteLastRune(*input)
+	case "ctrl+u":
+		*input = ""
+	default:
+		if len(keyMsg.Text) > 0 {
+			*input = appendPrintable(*input, keyMsg.Text)
+		}
+	}
+
+	return nil
+}
+
+// applyTrackerDialog validates the input and runs the dialog's action
+func (m *MainView) applyTrackerDialog() tea.Cmd {
+	d := m.trackerDialog
+	fail := func(err error) tea.Cmd {
+		return func() tea.Msg {
+			return errorMsg(err)
+		}
+	}
+
+	switch d.mode {
+	case TrackerModeAdd:
+		urls := trackerURLs(d.input)
+		if len(urls) == 0 {
+			return fail(fmt.Errorf("enter at least one tracker URL"))
+		}
+		for _, u := range urls {
+			if err := validateTrackerURL(u); err != nil {
+				return fail(err)
+			}
+		}
+		hash := d.hashes[0]
+		m.closeTrackerDialog()
+		return func() tea.Msg {
+			if err := m.apiClient.AddTrackers(context.Background(), hash, urls); err != nil {
+				return components.DetailsActionMsg{Err: fmt.Errorf("failed to add trackers: %w", err)}
+			}
+			return components.DetailsActionMsg{Success: fmt.Sprintf("added %d tracker(s)", len(urls))}
+		}
+
+	case TrackerModeEdit:
+		newURL := strings.TrimSpace(d.input)
+		if err := validateTrackerURL(newURL); err != nil {
+			return fail(err)
+		}
+		hash, oldURL := d.hashes[0], d.oldURL
+		m.closeTrackerDialog()
+		if newURL == oldURL {
+			return nil
+		}
+		return func() tea.Msg {
+			if err := m.apiClient.EditTracker(context.Background(), hash, oldURL, newURL); err != nil {
+				return components.DetailsActionMsg{Err: fmt.Errorf("failed to edit tracker: %w", err)}
+			}
+			return components.DetailsActionMsg{Success: fmt.Sprintf("tracker updated: %s", styles.TruncateString(newURL, 50))}
+		}
+
+	default:
+		find, replace := strings.TrimSpace(d.input), strings.TrimSpace(d.replaceInput)
+		if find == "" {
+			return fail(fmt.Errorf("enter the text to find in tracker URLs"))
+		}
+		if find == replace {
+			return fail(fmt.Errorf("the replacement is the same as the text to find"))
+		}
+		hashes := d.hashes
+		m.closeTrackerDialog()
+		return m.replaceTrackers(hashes, find, replace)
+	}
+}
+
+// replaceTrackers replaces find with replace in every tracker URL of the
+// torrents, e.g. when a private tracker moves to a new announce domain.
+// Torrents that fail are skipped so one conflict doesn't stop the rest.
+func (m *MainView) replaceTrackers(hashes []string, find, replace string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		edited, torrents := 0, 0
+		var firstErr error
+		record := func(err error) {
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+
+		for _, hash := range hashes {
+			trackers, err := m.apiClient.GetTorrentTrackers(ctx, hash)
+			if err != nil {
+				record(fmt.Errorf("failed to get trackers: %w", err))
+				continue
+			}
+
+			changed := false
+			for _, tracker := range trackers {
+				if tracker.IsPseudoTracker() || !strings.Contains(tracker.URL, find) {
+					continue
+				}
+				newURL := strings.ReplaceAll(tracker.URL, find, replace)
+				// qBittorrent rejects edits that keep the URL with a 409
+				if newURL == tracker.URL {
+					continue
+				}
+				if err := validateTrackerURL(newURL); err != nil {
+					record(err)
+					continue
+				}
+				if err := m.apiClient.EditTracker(ctx, hash, tracker.URL, newURL); err != nil {
+					record(fmt.Errorf("failed to edit tracker %s: %w", tracker.URL, err))
+					continue
+				}
+				edited++
+				changed = true
+			}
+			if changed {
+				torrents++
+			}
+		}
+
+		if firstErr != nil {
+			return errorMsg(fmt.Errorf("replaced %d tracker URL(s) in %s, some failed: %w", edited, pluralTorrents(torrents), firstErr))
+		}
+		if edited == 0 {
+			return errorMsg(fmt.Errorf("no tracker URLs contain %q", find))
+		}
+		return successMsg(fmt.Sprintf("replaced %d tracker URL(s) in %s", edited, pluralTorrents(torrents)))
+	}
+}
+
+// renderTrackerDialog renders the tracker dialog
+func (m *MainView) renderTrackerDialog() string {
+	d := m.trackerDialog
+
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	renderField := func(label, value string, focused bool) string {
+		inputStyle := styles.InputStyle.Width(60)
+		display := styles.TextStyle.Render(value)
+		if focused {
+			inputStyle = styles.FocusedInputStyle.Width(60)
+			display = styles.TextStyle.Render(value + "▊")
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, label, inputStyle.Render(display))
+	}
+
+	var title, hint, instructions string
+	var fields string
+	switch d.mode {
+	case TrackerModeAdd:
+		title = "Add Trackers"
+		fields = renderField("Tracker URLs:", d.input, true)
+		hint = "Separate several URLs with spaces"
+		instructions = "Enter: Add  Ctrl+U: Clear  Esc: Cancel"
+	case TrackerModeEdit:
+		title = "Edit Tracker"
+		fields = renderField("Tracker URL:", d.input, true)
+		hint = styles.TruncateString("Was: "+d.oldURL, 60)
+		instructions = "Enter: Save  Ctrl+U: Clear  Esc: Cancel"
+	default:
+		title = "Replace Tracker URLs"
+		fields = lipgloss.JoinVertical(lipgloss.Left,
+			renderField("Find:", d.input, !d.focusReplace),
+			renderField("Replace with:", d.replaceInput, d.focusReplace),
+		)
+		hint = "e.g. find old-tracker.example, replace with new-tracker.example"
+		instructions = "Tab: Switch field  Enter: Replace  Esc: Cancel"
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		styles.AccentStyle.Render(title),
+		styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(d.targetName, 50))),
+		"",
+		fields,
+		styles.DimStyle.Render(hint),
+		"",
+		styles.DimStyle.Render(instructions),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
+
+// renderRemoveTrackerDialog renders the remove tracker confirmation dialog
+func (m *MainView) renderRemoveTrackerDialog() string {
+	// Dialog box styling
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.AccentStyle.GetForeground()).
+		Padding(1, 2).
+		Width(70).
+		Align(lipgloss.Center)
+
+	torrent := m.removeTrackerTarget.Hash
+	if t, ok := m.torrentMap[torrent]; ok {
+		torrent = t.Name
+	}
+
+	dialogContent := lipgloss.JoinVertical(lipgloss.Center,
+		styles.AccentStyle.Render("Remove Tracker"),
+		styles.DimStyle.Render(fmt.Sprintf("Torrent: %s", styles.TruncateString(torrent, 50))),
+		"",
+		fmt.Sprintf("Tracker: %s", styles.TextStyle.Render(styles.TruncateString(m.removeTrackerTarget.URL, 50))),
+		"",
+		styles.DimStyle.Render("Y/Enter: Remove  N/Esc: Cancel"),
+	)
+
+	return dialogStyle.Render(dialogContent)
+}
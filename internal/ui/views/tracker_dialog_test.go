@@ -0,0 +1,129 @@
+package views
+
+import (
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/nickvanw/qbittorrent-tui/internal/api"
+	"github.com/nickvanw/qbittorrent-tui/internal/ui/components"
+)
+
+func TestTrackerDialogAddPastedList(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.handleTrackerRequest(components.TrackerRequestMsg{Hash: "hash1"})
+	m.Update(tea.PasteMsg{Content: "udp://a.example:80/announce\nhttps://b.example/announce\n"})
+
+	cmd := m.applyTrackerDialog()
+	if msg, ok := cmd().(components.DetailsActionMsg); !ok || msg.Err != nil {
+		t.Fatalf("expected success, got %#v", msg)
+	}
+	if len(mock.Trackers["hash1"]) != 2 {
+		t.Errorf("both pasted trackers should be added, got %v", mock.Trackers["hash1"])
+	}
+}
+
+func TestTrackerDialogRejectsInvalidURL(t *testing.T) {
+	m := newTestMainView()
+	m.handleTrackerRequest(components.TrackerRequestMsg{Hash: "hash1", URL: "https://old.example/announce"})
+	m.trackerDialog.input = "not a url"
+
+	if _, ok := m.applyTrackerDialog()().(errorMsg); !ok {
+		t.Error("invalid tracker URL should produce an error")
+	}
+	if !m.showTrackerDialog {
+		t.Error("dialog should stay open on invalid input")
+	}
+}
+
+func TestReplaceTrackersRejectsSameText(t *testing.T) {
+	m := newTestMainView()
+	m.torrentMap["hash1"] = api.Torrent{Hash: "hash1", Name: "alpha"}
+	m.torrentList.SetTorrents([]api.Torrent{m.torrentMap["hash1"]})
+	m.handleReplaceTrackers()
+	m.trackerDialog.input = "old.example"
+	m.trackerDialog.replaceInput = " old.example "
+
+	if _, ok := m.applyTrackerDialog()().(errorMsg); !ok {
+		t.Error("replacing text with itself should produce an error")
+	}
+	if !m.showTrackerDialog {
+		t.Error("dialog should stay open on invalid input")
+	}
+}
+
+func TestRemoveTrackerConfirmation(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Trackers["hash1"] = []api.Tracker{{URL: "https://a.example/announce"}, {URL: "https://b.example/announce"}}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	m.torrentDetails = components.NewTorrentDetails(mock)
+
+	req := components.TrackerRemoveRequestMsg{Hash: "hash1", URL: "https://a.example/announce"}
+	m.Update(req)
+	if !m.showRemoveTrackerDialog {
+		t.Fatal("remove request should ask for confirmation")
+	}
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.showRemoveTrackerDialog || len(mock.Trackers["hash1"]) != 2 {
+		t.Fatal("esc should cancel without removing")
+	}
+
+	m.Update(req)
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("expected a command to remove the tracker")
+	}
+	if msg, ok := cmd().(components.DetailsActionMsg); !ok || msg.Err != nil {
+		t.Fatalf("unexpected result %#v", msg)
+	}
+	if len(mock.Trackers["hash1"]) != 1 || mock.Trackers["hash1"][0].URL != "https://b.example/announce" {
+		t.Errorf("tracker should be removed, got %v", mock.Trackers["hash1"])
+	}
+}
+
+func TestReplaceTrackersAcrossTorrents(t *testing.T) {
+	mock := api.NewMockClient()
+	mock.LoggedIn = true
+	mock.Torrents = []api.Torrent{{Hash: "hash1", Name: "alpha"}, {Hash: "hash2", Name: "beta"}, {Hash: "hash3", Name: "gamma"}}
+	mock.Trackers["hash1"] = []api.Tracker{{URL: "** [DHT] **"}, {URL: "https://old.example/announce/key1"}}
+	mock.Trackers["hash2"] = []api.Tracker{{URL: "https://old.example/announce/key1"}, {URL: "udp://public.example:80"}}
+	mock.Trackers["hash3"] = []api.Tracker{{URL: "https://old.example/announce/key1"}}
+
+	m := newTestMainView()
+	m.apiClient = mock
+	for _, torrent := range mock.Torrents {
+		m.torrentMap[torrent.Hash] = torrent
+	}
+	m.torrentList.SetTorrents(mock.Torrents)
+	m.torrentList.ToggleMark() // alpha and beta, rows are sorted by name
+	m.torrentList.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	m.torrentList.ToggleMark()
+
+	m.handleReplaceTrackers()
+	m.trackerDialog.input = "old.example"
+	m.trackerDialog.replaceInput = "new.example"
+	msg := m.applyTrackerDialog()()
+	if success, ok := msg.(successMsg); !ok || !strings.Contains(string(success), "replaced 2 tracker URL(s) in 2 torrents") {
+		t.Fatalf("unexpected result %#v", msg)
+	}
+
+	for hash, want := range map[string]string{"hash1": "https://new.example/announce/key1", "hash2": "https://new.example/announce/key1"} {
+		found := false
+		for _, tracker := range mock.Trackers[hash] {
+			found = found || tracker.URL == want
+		}
+		if !found {
+			t.Errorf("%s should have tracker %s, got %v", hash, want, mock.Trackers[hash])
+		}
+	}
+	if mock.Trackers["hash3"][0].URL != "https://old.example/announce/key1" {
+		t.Error("unmarked torrents should be left alone")
+	}
+}